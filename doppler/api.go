@@ -4,22 +4,46 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type APIClient struct {
-	Host      string
-	APIKey    string
-	VerifyTLS bool
+	Host           string
+	APIKey         string
+	VerifyTLS      bool
+	RootCAs        *x509.CertPool
+	ProxyURL       *url.URL
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	PageSize       int
+	// The longest a single request may take. When zero, requests are only bounded by their context.
+	RequestTimeout time.Duration
+	// How a resource that no longer exists is handled during refresh: "remove" (from state) or "error"
+	NotFoundBehavior string
+	// Logs redacted request and response bodies at the DEBUG level
+	DebugHTTP bool
+	// Limits the number of in-flight requests when non-nil. The channel is shared by copies of the client.
+	requestSlots chan struct{}
+	// Caches secret downloads by ETag when non-nil
+	responseCache *responseCache
+	// Reports the API's deprecation notices as warnings when non-nil
+	deprecationWarnings *deprecationWarnings
 }
 
 type APIResponse struct {
@@ -36,10 +60,18 @@ type APIError struct {
 
 type ErrorResponse struct {
 	Messages []string
+	Errors   []ErrorDetail
 	Success  bool
 	Data     map[string]interface{}
 }
 
+// ErrorDetail is a structured error in an API error response, e.g. a validation error for a single field
+type ErrorDetail struct {
+	Code    string
+	Field   string
+	Message string
+}
+
 type QueryParam struct {
 	Key   string
 	Value string
@@ -52,14 +84,56 @@ type PageOptions struct {
 
 const MAX_RETRIES = 10
 
+// The number of items requested per page when listing every item of a paginated endpoint
+const DEFAULT_PAGE_SIZE = 100
+
+// The default for the longest a single request may take
+const DEFAULT_REQUEST_TIMEOUT = 30 * time.Second
+
+// The longest the client will back off between two attempts, unless the API asks for a longer delay.
+const MAX_RETRY_DELAY = 30 * time.Second
+
 func (e *APIError) Error() string {
 	message := fmt.Sprintf("Doppler Error: %s", e.Message)
 	if underlyingError := e.Err; underlyingError != nil {
 		message = fmt.Sprintf("%s\n%s", message, underlyingError.Error())
 	}
+	if e.Response != nil && e.Response.HTTPResponse != nil {
+		message = fmt.Sprintf("%s\n%s", message, describeResponse(e.Response))
+	}
 	return message
 }
 
+// describeResponse summarizes the request that produced an error response and the structured errors in
+// its body, e.g.
+// "HTTP 400 Bad Request from POST /v3/configs/config/secrets (request ID: ...)
+// - name: Secret names must be uppercase (code: invalid_secret_name)"
+func describeResponse(response *APIResponse) string {
+	r := response.HTTPResponse
+	description := fmt.Sprintf("HTTP %s", r.Status)
+	if r.Request != nil && r.Request.URL != nil {
+		description = fmt.Sprintf("%s from %s %s", description, r.Request.Method, r.Request.URL.Path)
+	}
+	if requestID := r.Header.Get("x-request-id"); requestID != "" {
+		description = fmt.Sprintf("%s (request ID: %s)", description, requestID)
+	}
+	var errResponse ErrorResponse
+	if err := json.Unmarshal(response.Body, &errResponse); err != nil {
+		return description
+	}
+	for _, detail := range errResponse.Errors {
+		line := detail.Message
+		if detail.Field != "" {
+			line = fmt.Sprintf("%s: %s", detail.Field, line)
+		}
+		if detail.Code != "" {
+			line = fmt.Sprintf("%s (code: %s)", line, detail.Code)
+		}
+		description = fmt.Sprintf("%s\n- %s", description, line)
+	}
+	return description
+}
+
 func isSuccess(statusCode int) bool {
 	return (statusCode >= 200 && statusCode <= 299) || (statusCode >= 300 && statusCode <= 399)
 }
@@ -69,9 +143,108 @@ func getSecondsDuration(seconds int) *time.Duration {
 	return &duration
 }
 
+func (client APIClient) pageSize() int {
+	if client.PageSize > 0 {
+		return client.PageSize
+	}
+	return DEFAULT_PAGE_SIZE
+}
+
+// listAllPages calls fetch with increasing page numbers until a page has fewer than `perPage` items.
+func listAllPages[T any](perPage int, fetch func(pageOptions PageOptions) ([]T, error)) ([]T, error) {
+	items := []T{}
+	for page := 1; ; page++ {
+		pageItems, err := fetch(PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		if len(pageItems) < perPage {
+			return items, nil
+		}
+	}
+}
+
+// retryDelay returns how long to wait before retrying after the given (zero-indexed) attempt.
+// The delay grows exponentially from the client's base delay with jitter applied,
+// and is never shorter than the delay requested by the API.
+func (client APIClient) retryDelay(attempt int, requested time.Duration) time.Duration {
+	delay := time.Duration(0)
+	if client.RetryBaseDelay > 0 {
+		delay = MAX_RETRY_DELAY
+		if attempt < 16 {
+			if backoff := client.RetryBaseDelay << uint(attempt); backoff < MAX_RETRY_DELAY {
+				delay = backoff
+			}
+		}
+		// "Equal jitter": wait between half and all of the computed backoff
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+	if requested > delay {
+		delay = requested
+	}
+	return delay
+}
+
+// isIdempotent reports whether a request with the given method can safely be sent more than once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isTransientStatus reports whether a status code indicates a temporary gateway or availability problem
+func isTransientStatus(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
+// isConnectionReset reports whether the connection was dropped before a response was received
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// sleepWithContext waits for the given duration, returning early with the context's error if it is cancelled
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// parseRetryAfter parses a `retry-after` header, which may be either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) *time.Duration {
+	if seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil && seconds >= 0 {
+		return getSecondsDuration(int(seconds))
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		duration := time.Until(date)
+		if duration < 0 {
+			duration = 0
+		}
+		return &duration
+	}
+	// The header is missing or malformed, this shouldn't happen but retry after 1 second
+	return getSecondsDuration(1)
+}
+
 func (client APIClient) PerformRequestWithRetry(ctx context.Context, method string, path string, params []QueryParam, body []byte) (*APIResponse, error) {
+	return client.performRequestWithRetry(ctx, method, path, params, body, nil)
+}
+
+func (client APIClient) performRequestWithRetry(ctx context.Context, method string, path string, params []QueryParam, body []byte, headers map[string]string) (*APIResponse, error) {
+	maxRetries := client.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = MAX_RETRIES
+	}
+
 	var lastErr error
-	for i := 0; i < MAX_RETRIES; i++ {
+	for i := 0; i < maxRetries; i++ {
 		url := fmt.Sprintf("%s%s", client.Host, path)
 		var bodyReader io.Reader
 		if body != nil {
@@ -81,8 +254,27 @@ func (client APIClient) PerformRequestWithRetry(ctx context.Context, method stri
 		if err != nil {
 			return nil, &APIError{Err: err, Message: "Unable to form request"}
 		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
 
+		if client.requestSlots != nil {
+			select {
+			case client.requestSlots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, &APIError{Err: ctx.Err(), Message: "Unable to send request"}
+			}
+		}
+		start := time.Now()
 		response, err := client.PerformRequest(req, params)
+		if client.requestSlots != nil {
+			<-client.requestSlots
+		}
+		logRequest(ctx, method, path, i+1, response, err, time.Since(start))
+		recordDeprecationNotices(ctx, method, path, response, err)
+		if client.DebugHTTP {
+			logHTTPExchange(ctx, req, body, response, err)
+		}
 		lastErr = err
 		if err == nil {
 			return response, nil
@@ -91,17 +283,48 @@ func (client APIClient) PerformRequestWithRetry(ctx context.Context, method stri
 		if !isAPIError || apiError.RetryAfter == nil {
 			return nil, err
 		}
-		time.Sleep(*apiError.RetryAfter)
+		if i < maxRetries-1 {
+			if err := sleepWithContext(ctx, client.retryDelay(i, *apiError.RetryAfter)); err != nil {
+				return nil, &APIError{Err: err, Message: "Request cancelled while waiting to retry", Response: apiError.Response}
+			}
+		}
 	}
 	return nil, lastErr
 }
 
+// logRequest records a completed API request. Query parameters, headers, and bodies are not logged
+// since they may contain secret values (see `logHTTPExchange` for redacted bodies).
+func logRequest(ctx context.Context, method string, path string, attempt int, response *APIResponse, err error, duration time.Duration) {
+	fields := map[string]interface{}{
+		"method":      method,
+		"path":        path,
+		"attempt":     attempt,
+		"duration_ms": duration.Milliseconds(),
+	}
+	if apiError, ok := err.(*APIError); ok && response == nil {
+		response = apiError.Response
+	}
+	if response != nil && response.HTTPResponse != nil {
+		fields["status_code"] = response.HTTPResponse.StatusCode
+		if requestID := response.HTTPResponse.Header.Get("x-request-id"); requestID != "" {
+			fields["request_id"] = requestID
+		}
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Doppler API request failed", fields)
+	} else {
+		tflog.Debug(ctx, "Doppler API request", fields)
+	}
+}
+
 func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (*APIResponse, error) {
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	httpClient := &http.Client{Timeout: client.RequestTimeout}
 
 	userAgent := fmt.Sprintf("terraform-provider-doppler/%s", ProviderVersion)
 	req.Header.Set("user-agent", userAgent)
-	req.SetBasicAuth(client.APIKey, "")
+	if client.APIKey != "" {
+		req.SetBasicAuth(client.APIKey, "")
+	}
 	if req.Header.Get("accept") == "" {
 		req.Header.Set("accept", "application/json")
 	}
@@ -121,16 +344,31 @@ func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (
 		tlsConfig.InsecureSkipVerify = true
 	}
 
+	if client.RootCAs != nil {
+		tlsConfig.RootCAs = client.RootCAs
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if client.ProxyURL != nil {
+		proxy = http.ProxyURL(client.ProxyURL)
+	}
+
 	httpClient.Transport = &http.Transport{
 		DisableKeepAlives: true,
 		TLSClientConfig:   tlsConfig,
+		Proxy:             proxy,
 	}
 
 	r, err := httpClient.Do(req)
 	if err != nil {
 		var retryAfter *time.Duration
-		if e, ok := err.(net.Error); ok && e.Timeout() {
+		if req.Context().Err() != nil {
+			// The request was cancelled (e.g. by an interrupted apply) or its deadline passed, so don't retry
+			retryAfter = nil
+		} else if e, ok := err.(net.Error); ok && e.Timeout() {
 			retryAfter = getSecondsDuration(1)
+		} else if isIdempotent(req.Method) && isConnectionReset(err) {
+			retryAfter = getSecondsDuration(0)
 		}
 
 		return nil, &APIError{Err: err, Message: "Unable to load response", RetryAfter: retryAfter}
@@ -161,16 +399,10 @@ func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (
 			} else if retryableAfterSec, ok := errResponse.Data["isRetryableAfterSec"].(float64); ok {
 				// Retry after specified time
 				retryAfter = getSecondsDuration(int(retryableAfterSec))
-			} else if r.StatusCode == 429 {
-				retryAfterStr := r.Header.Get("retry-after")
-				retryAfterInt, err := strconv.ParseInt(retryAfterStr, 10, 64)
-				if err == nil {
-					// Parse successful `retry-after` header result
-					retryAfter = getSecondsDuration(int(retryAfterInt))
-				} else {
-					// There was some issue parsing, this shouldn't happen but retry after 1 second
-					retryAfter = getSecondsDuration(1)
-				}
+			} else if r.StatusCode == http.StatusTooManyRequests {
+				retryAfter = parseRetryAfter(r.Header.Get("retry-after"))
+			} else if isTransientStatus(r.StatusCode) && isIdempotent(req.Method) {
+				retryAfter = getSecondsDuration(0)
 			} else {
 				// Otherwise, do not retry
 				retryAfter = nil
@@ -182,6 +414,14 @@ func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (
 				Response:   response,
 			}
 		}
+		if r.StatusCode == http.StatusTooManyRequests {
+			// Rate limit responses from proxies and gateways may not be JSON
+			return response, &APIError{Err: nil, Message: "Rate limit exceeded", RetryAfter: parseRetryAfter(r.Header.Get("retry-after")), Response: response}
+		}
+		if isTransientStatus(r.StatusCode) && isIdempotent(req.Method) {
+			// Gateway errors during maintenance or deploys usually aren't JSON
+			return response, &APIError{Err: fmt.Errorf("%d status code", r.StatusCode), Message: "Service temporarily unavailable", RetryAfter: getSecondsDuration(0), Response: response}
+		}
 		return nil, &APIError{Err: fmt.Errorf("%d status code; %d bytes", r.StatusCode, len(body)), Message: "Unable to load response", Response: response}
 	}
 	if err != nil {
@@ -190,6 +430,44 @@ func (client APIClient) PerformRequest(req *http.Request, params []QueryParam) (
 	return response, nil
 }
 
+// Auth
+
+func (client APIClient) ExchangeOIDCToken(ctx context.Context, identity string, token string) (*OIDCAuthResponse, error) {
+	// The exchange is authenticated by the OIDC token alone, so never send a configured Doppler token with it
+	client.APIKey = ""
+	payload := map[string]interface{}{
+		"identity": identity,
+		"token":    token,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize OIDC auth request"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/auth/oidc", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result OIDCAuthResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse OIDC auth response"}
+	}
+	return &result, nil
+}
+
+// Me
+
+func (client APIClient) GetMe(ctx context.Context) (*Me, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/me", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result Me
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse token info"}
+	}
+	return &result, nil
+}
+
 // Secrets
 
 func (client APIClient) GetComputedSecrets(ctx context.Context, project string, config string) ([]ComputedSecret, error) {
@@ -200,17 +478,64 @@ func (client APIClient) GetComputedSecrets(ctx context.Context, project string,
 	if config != "" {
 		params = append(params, QueryParam{Key: "config", Value: config})
 	}
-	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/secrets/download", params, nil)
+	body, err := client.performCachedGet(ctx, "/v3/configs/config/secrets/download", params)
 	if err != nil {
 		return nil, err
 	}
-	result, err := ParseComputedSecrets(response.Body)
+	result, err := ParseComputedSecrets(body)
 	if err != nil {
 		return nil, &APIError{Err: err, Message: "Unable to parse secrets"}
 	}
 	return result, nil
 }
 
+// DownloadSecrets returns the config's secrets rendered in the given format (e.g. `json` or `env`)
+func (client APIClient) DownloadSecrets(ctx context.Context, project string, config string, format string, nameTransformer string) (string, error) {
+	params := []QueryParam{
+		{Key: "format", Value: format},
+	}
+	if project != "" {
+		params = append(params, QueryParam{Key: "project", Value: project})
+	}
+	if config != "" {
+		params = append(params, QueryParam{Key: "config", Value: config})
+	}
+	if nameTransformer != "" {
+		params = append(params, QueryParam{Key: "name_transformer", Value: nameTransformer})
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/secrets/download", params, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(response.Body), nil
+}
+
+func (client APIClient) GetSecrets(ctx context.Context, project string, config string) ([]Secret, error) {
+	var params []QueryParam
+	if project != "" {
+		params = append(params, QueryParam{Key: "project", Value: project})
+	}
+	if config != "" {
+		params = append(params, QueryParam{Key: "config", Value: config})
+	}
+	body, err := client.performCachedGet(ctx, "/v3/configs/config/secrets", params)
+	if err != nil {
+		return nil, err
+	}
+	var result SecretsResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse secrets"}
+	}
+	secrets := make([]Secret, 0, len(result.Secrets))
+	for name, value := range result.Secrets {
+		secrets = append(secrets, Secret{Name: name, Value: value})
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
 func (client APIClient) GetSecret(ctx context.Context, project string, config string, secretName string) (*Secret, error) {
 	var params []QueryParam
 	if project != "" {
@@ -260,6 +585,51 @@ func (client APIClient) UpdateSecrets(ctx context.Context, project string, confi
 	return nil
 }
 
+func (client APIClient) UpdateSecretNote(ctx context.Context, project string, config string, secretName string, note string) error {
+	payload := map[string]interface{}{
+		"secret": secretName,
+		"note":   note,
+	}
+	if project != "" {
+		payload["project"] = project
+	}
+	if config != "" {
+		payload["config"] = config
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return &APIError{Err: err, Message: "Unable to serialize secret note"}
+	}
+	_, err = client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/secrets/note", []QueryParam{}, body)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Share
+
+func (client APIClient) CreateShareLink(ctx context.Context, secret string, expireViews int, expireDays int) (*ShareLink, error) {
+	payload := map[string]interface{}{
+		"secret":       secret,
+		"expire_views": expireViews,
+		"expire_days":  expireDays,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize share link"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v1/share/secrets/plain", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result ShareLink
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse share link"}
+	}
+	return &result, nil
+}
+
 // Projects
 
 func (client APIClient) GetProject(ctx context.Context, name string) (*Project, error) {
@@ -343,6 +713,18 @@ func (client APIClient) DeleteProject(ctx context.Context, name string) error {
 
 // Project Roles
 
+func (client APIClient) ListProjectRoles(ctx context.Context) ([]ProjectRole, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/projects/roles", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ProjectRolesResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse project roles"}
+	}
+	return result.Roles, nil
+}
+
 func (client APIClient) CreateProjectRole(ctx context.Context, name string, permissions []string) (*ProjectRole, error) {
 	payload := map[string]interface{}{
 		"name":        name,
@@ -977,6 +1359,32 @@ func (client APIClient) GetConfig(ctx context.Context, project string, name stri
 	return &result.Config, nil
 }
 
+func (client APIClient) ListConfigs(ctx context.Context, project string, environment string, pageOptions PageOptions) ([]Config, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	if environment != "" {
+		params = append(params, QueryParam{Key: "environment", Value: environment})
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ConfigsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse configs"}
+	}
+	return result.Configs, nil
+}
+
+func (client APIClient) ListAllConfigs(ctx context.Context, project string, environment string) ([]Config, error) {
+	return listAllPages(client.pageSize(), func(pageOptions PageOptions) ([]Config, error) {
+		return client.ListConfigs(ctx, project, environment, pageOptions)
+	})
+}
+
 func (client APIClient) CreateConfig(ctx context.Context, project string, environment string, name string) (*Config, error) {
 	payload := map[string]interface{}{
 		"project":     project,
@@ -1069,6 +1477,34 @@ func (client APIClient) UpdateConfigInherits(ctx context.Context, project string
 	return &result.Config, nil
 }
 
+func (client APIClient) LockConfig(ctx context.Context, project string, config string) (*Config, error) {
+	return client.setConfigLock(ctx, project, config, "lock")
+}
+
+func (client APIClient) UnlockConfig(ctx context.Context, project string, config string) (*Config, error) {
+	return client.setConfigLock(ctx, project, config, "unlock")
+}
+
+func (client APIClient) setConfigLock(ctx context.Context, project string, config string, action string) (*Config, error) {
+	payload := map[string]interface{}{
+		"project": project,
+		"config":  config,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize config"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/"+action, []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result ConfigResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse config"}
+	}
+	return &result.Config, nil
+}
+
 func (client APIClient) DeleteConfig(ctx context.Context, project string, name string) error {
 	payload := map[string]interface{}{
 		"project": project,
@@ -1086,6 +1522,62 @@ func (client APIClient) DeleteConfig(ctx context.Context, project string, name s
 	return nil
 }
 
+// Trusted IPs
+
+func (client APIClient) GetTrustedIPs(ctx context.Context, project string, config string) ([]string, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/trusted_ips", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result TrustedIPsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse trusted IPs"}
+	}
+	return result.IPs, nil
+}
+
+func (client APIClient) AddTrustedIP(ctx context.Context, project string, config string, ip string) error {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+	}
+	payload := map[string]interface{}{
+		"ip": ip,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return &APIError{Err: err, Message: "Unable to serialize trusted IP"}
+	}
+	_, err = client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/trusted_ips", params, body)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (client APIClient) DeleteTrustedIP(ctx context.Context, project string, config string, ip string) error {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+	}
+	payload := map[string]interface{}{
+		"ip": ip,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return &APIError{Err: err, Message: "Unable to serialize trusted IP"}
+	}
+	_, err = client.PerformRequestWithRetry(ctx, "DELETE", "/v3/configs/config/trusted_ips", params, body)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Service Tokens
 
 func (client APIClient) GetServiceTokens(ctx context.Context, project string, config string) ([]ServiceToken, error) {
@@ -1104,13 +1596,16 @@ func (client APIClient) GetServiceTokens(ctx context.Context, project string, co
 	return result.ServiceTokens, nil
 }
 
-func (client APIClient) CreateServiceToken(ctx context.Context, project string, config string, access string, name string) (*ServiceToken, error) {
+func (client APIClient) CreateServiceToken(ctx context.Context, project string, config string, access string, name string, expireAt string) (*ServiceToken, error) {
 	payload := map[string]interface{}{
 		"project": project,
 		"config":  config,
 		"access":  access,
 		"name":    name,
 	}
+	if expireAt != "" {
+		payload["expire_at"] = expireAt
+	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, &APIError{Err: err, Message: "Unable to serialize service token"}
@@ -1146,6 +1641,28 @@ func (client APIClient) DeleteServiceToken(ctx context.Context, project string,
 
 // Service Accounts
 
+func (client APIClient) ListServiceAccounts(ctx context.Context, pageOptions PageOptions) ([]ServiceAccount, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/service_accounts", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ServiceAccountsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse service accounts"}
+	}
+	return result.ServiceAccounts, nil
+}
+
+func (client APIClient) ListAllServiceAccounts(ctx context.Context) ([]ServiceAccount, error) {
+	return listAllPages(client.pageSize(), func(pageOptions PageOptions) ([]ServiceAccount, error) {
+		return client.ListServiceAccounts(ctx, pageOptions)
+	})
+}
+
 func (client APIClient) GetServiceAccount(ctx context.Context, slug string) (*ServiceAccount, error) {
 	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/service_accounts/service_account/%s", url.QueryEscape(slug)), []QueryParam{}, nil)
 	if err != nil {
@@ -1219,6 +1736,28 @@ func (client APIClient) DeleteServiceAccount(ctx context.Context, slug string) e
 
 // Service Account Tokens
 
+func (client APIClient) ListServiceAccountTokens(ctx context.Context, serviceAccountSlug string, pageOptions PageOptions) ([]ServiceAccountToken, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/service_accounts/service_account/%s/tokens", url.QueryEscape(serviceAccountSlug)), params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ServiceAccountTokensResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse service account tokens"}
+	}
+	return result.ServiceAccountTokens, nil
+}
+
+func (client APIClient) ListAllServiceAccountTokens(ctx context.Context, serviceAccountSlug string) ([]ServiceAccountToken, error) {
+	return listAllPages(client.pageSize(), func(pageOptions PageOptions) ([]ServiceAccountToken, error) {
+		return client.ListServiceAccountTokens(ctx, serviceAccountSlug, pageOptions)
+	})
+}
+
 func (client APIClient) GetServiceAccountToken(ctx context.Context, serviceAccountSlug string, slug string) (ServiceAccountTokenResponse, error) {
 	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/service_accounts/service_account/%s/tokens/token/%s", url.QueryEscape(serviceAccountSlug), url.QueryEscape(slug)), []QueryParam{}, nil)
 	if err != nil {
@@ -1463,6 +2002,12 @@ func (client APIClient) GetGroupMembers(ctx context.Context, group string, pageO
 	return result.Members, nil
 }
 
+func (client APIClient) GetAllGroupMembers(ctx context.Context, group string) ([]GroupMember, error) {
+	return listAllPages(client.pageSize(), func(pageOptions PageOptions) ([]GroupMember, error) {
+		return client.GetGroupMembers(ctx, group, pageOptions)
+	})
+}
+
 func (client APIClient) ReplaceGroupMembers(ctx context.Context, group string, members []GroupMember) error {
 	payload := map[string]interface{}{
 		"members": members,
@@ -1478,6 +2023,46 @@ func (client APIClient) ReplaceGroupMembers(ctx context.Context, group string, m
 	return nil
 }
 
+// Workplace
+
+func (client APIClient) GetWorkplace(ctx context.Context) (*Workplace, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace"}
+	}
+	return &result.Workplace, nil
+}
+
+func (client APIClient) UpdateWorkplace(ctx context.Context, name string, billingEmail string, securityEmail string) (*Workplace, error) {
+	payload := map[string]interface{}{}
+	if name != "" {
+		payload["name"] = name
+	}
+	if billingEmail != "" {
+		payload["billing_email"] = billingEmail
+	}
+	if securityEmail != "" {
+		payload["security_email"] = securityEmail
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/workplace", []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace"}
+	}
+	return &result.Workplace, nil
+}
+
 // Workplace Users
 
 func (client APIClient) GetWorkplaceUser(ctx context.Context, email string) (*WorkplaceUser, error) {
@@ -1501,6 +2086,45 @@ func (client APIClient) GetWorkplaceUser(ctx context.Context, email string) (*Wo
 	return &result.WorkplaceUsers[0], nil
 }
 
+func (client APIClient) GetWorkplaceUserBySlug(ctx context.Context, slug string) (*WorkplaceUser, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", fmt.Sprintf("/v3/workplace/users/%s", url.PathEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUserResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace user"}
+	}
+	return &result.WorkplaceUser, nil
+}
+
+func (client APIClient) UpdateWorkplaceUserAccess(ctx context.Context, slug string, access string) (*WorkplaceUser, error) {
+	payload := map[string]interface{}{
+		"access": access,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to serialize workplace user"}
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "PATCH", fmt.Sprintf("/v3/workplace/users/%s", url.PathEscape(slug)), []QueryParam{}, body)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceUserResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace user"}
+	}
+	return &result.WorkplaceUser, nil
+}
+
+func (client APIClient) DeleteWorkplaceUser(ctx context.Context, slug string) error {
+	_, err := client.PerformRequestWithRetry(ctx, "DELETE", fmt.Sprintf("/v3/workplace/users/%s", url.PathEscape(slug)), []QueryParam{}, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Change Request Policies
 
 func (client APIClient) GetChangeRequestPolicy(ctx context.Context, slug string) (*ChangeRequestPolicy, error) {
@@ -1561,6 +2185,18 @@ func (client APIClient) DeleteChangeRequestPolicy(ctx context.Context, slug stri
 
 // Workplace Roles
 
+func (client APIClient) ListWorkplaceRoles(ctx context.Context) ([]WorkplaceRole, error) {
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/workplace/roles", []QueryParam{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result WorkplaceRolesResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse workplace roles"}
+	}
+	return result.Roles, nil
+}
+
 func (client APIClient) CreateWorkplaceRole(ctx context.Context, name string, permissions []string) (*WorkplaceRole, error) {
 	payload := map[string]interface{}{
 		"name":        name,
@@ -1620,3 +2256,76 @@ func (client APIClient) DeleteWorkplaceRole(ctx context.Context, identifier stri
 	}
 	return nil
 }
+
+// Config Logs
+
+func (client APIClient) ListConfigLogs(ctx context.Context, project string, config string, pageOptions PageOptions) ([]ConfigLog, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/logs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ConfigLogsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse config logs"}
+	}
+	return result.Logs, nil
+}
+
+func (client APIClient) GetConfigLog(ctx context.Context, project string, config string, id string) (*ConfigLog, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+		{Key: "log", Value: id},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/configs/config/logs/log", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ConfigLogResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse config log"}
+	}
+	return &result.Log, nil
+}
+
+// RollbackConfigLog restores the config's secrets to their state after the given log, returning the log of the rollback
+func (client APIClient) RollbackConfigLog(ctx context.Context, project string, config string, id string) (*ConfigLog, error) {
+	params := []QueryParam{
+		{Key: "project", Value: project},
+		{Key: "config", Value: config},
+		{Key: "log", Value: id},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "POST", "/v3/configs/config/logs/log/rollback", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ConfigLogResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse config log"}
+	}
+	return &result.Log, nil
+}
+
+// Activity Logs
+
+func (client APIClient) ListActivityLogs(ctx context.Context, pageOptions PageOptions) ([]ActivityLog, error) {
+	params := []QueryParam{
+		{Key: "page", Value: strconv.Itoa(pageOptions.Page)},
+		{Key: "per_page", Value: strconv.Itoa(pageOptions.PerPage)},
+	}
+	response, err := client.PerformRequestWithRetry(ctx, "GET", "/v3/logs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result ActivityLogsResponse
+	if err = json.Unmarshal(response.Body, &result); err != nil {
+		return nil, &APIError{Err: err, Message: "Unable to parse activity logs"}
+	}
+	return result.Logs, nil
+}
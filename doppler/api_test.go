@@ -0,0 +1,154 @@
+package doppler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestAPIErrorDescribesResponse(t *testing.T) {
+	request := &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v3/configs/config/secrets"}}
+	tests := []struct {
+		name     string
+		message  string
+		body     string
+		expected string
+	}{
+		{
+			name:     "messages only",
+			message:  "Invalid secret name",
+			body:     `{"messages":["Invalid secret name"],"success":false}`,
+			expected: "Doppler Error: Invalid secret name\nHTTP 400 Bad Request from POST /v3/configs/config/secrets (request ID: req_1)",
+		},
+		{
+			name:    "structured errors",
+			message: "Invalid request",
+			body:    `{"messages":["Invalid request"],"errors":[{"code":"invalid_secret_name","field":"name","message":"Secret names must be uppercase"},{"message":"Value is required"}],"success":false}`,
+			expected: "Doppler Error: Invalid request\nHTTP 400 Bad Request from POST /v3/configs/config/secrets (request ID: req_1)" +
+				"\n- name: Secret names must be uppercase (code: invalid_secret_name)" +
+				"\n- Value is required",
+		},
+		{
+			name:     "non-JSON body",
+			message:  "Unable to load response",
+			body:     "<html>Bad Request</html>",
+			expected: "Doppler Error: Unable to load response\nHTTP 400 Bad Request from POST /v3/configs/config/secrets (request ID: req_1)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := &APIResponse{
+				HTTPResponse: &http.Response{
+					Status:     "400 Bad Request",
+					StatusCode: http.StatusBadRequest,
+					Header:     http.Header{"X-Request-Id": []string{"req_1"}},
+					Request:    request,
+				},
+				Body: []byte(test.body),
+			}
+			err := &APIError{Message: test.message, Response: response}
+			if got := err.Error(); got != test.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name      string
+		baseDelay time.Duration
+		attempt   int
+		requested time.Duration
+		min       time.Duration
+		max       time.Duration
+	}{
+		{name: "no base delay", attempt: 3, min: 0, max: 0},
+		{name: "no base delay with requested delay", attempt: 3, requested: 2 * time.Second, min: 2 * time.Second, max: 2 * time.Second},
+		{name: "first attempt", baseDelay: time.Second, attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{name: "backoff doubles", baseDelay: time.Second, attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "backoff is capped", baseDelay: time.Second, attempt: 10, min: MAX_RETRY_DELAY / 2, max: MAX_RETRY_DELAY},
+		{name: "large attempts don't overflow", baseDelay: time.Second, attempt: 100, min: MAX_RETRY_DELAY / 2, max: MAX_RETRY_DELAY},
+		{name: "requested delay is longer", baseDelay: time.Second, attempt: 0, requested: 10 * time.Second, min: 10 * time.Second, max: 10 * time.Second},
+		{name: "requested delay beyond the cap", baseDelay: time.Second, attempt: 10, requested: time.Minute, min: time.Minute, max: time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := APIClient{RetryBaseDelay: test.baseDelay}
+			// The delay is jittered, so check it stays in range over several samples
+			for i := 0; i < 100; i++ {
+				if delay := client.retryDelay(test.attempt, test.requested); delay < test.min || delay > test.max {
+					t.Fatalf("Expected a delay between %s and %s, got %s", test.min, test.max, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{name: "seconds", value: "5", min: 5 * time.Second, max: 5 * time.Second},
+		{name: "zero seconds", value: "0", min: 0, max: 0},
+		{name: "padded seconds", value: " 2 ", min: 2 * time.Second, max: 2 * time.Second},
+		{name: "HTTP date", value: time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), min: 8 * time.Second, max: 10 * time.Second},
+		{name: "HTTP date in the past", value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), min: 0, max: 0},
+		{name: "missing", value: "", min: time.Second, max: time.Second},
+		{name: "negative seconds", value: "-1", min: time.Second, max: time.Second},
+		{name: "malformed", value: "soon", min: time.Second, max: time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			delay := parseRetryAfter(test.value)
+			if delay == nil {
+				t.Fatal("Expected a delay")
+			}
+			if *delay < test.min || *delay > test.max {
+				t.Errorf("Expected a delay between %s and %s, got %s", test.min, test.max, *delay)
+			}
+		})
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"project":{"name":"backend"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		requestTimeout time.Duration
+		contextTimeout time.Duration
+		fails          bool
+	}{
+		{name: "within the request timeout", requestTimeout: time.Second},
+		{name: "exceeds the request timeout", requestTimeout: 50 * time.Millisecond, fails: true},
+		{name: "no request timeout", requestTimeout: 0},
+		{name: "no request timeout bounded by the context", requestTimeout: 0, contextTimeout: 50 * time.Millisecond, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := APIClient{Host: server.URL, MaxRetries: 1, RequestTimeout: test.requestTimeout}
+			ctx := t.Context()
+			if test.contextTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.contextTimeout)
+				defer cancel()
+			}
+			_, err := client.GetProject(ctx, "backend")
+			if failed := err != nil; failed != test.fails {
+				t.Errorf("Expected failure=%t, got %v", test.fails, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package doppler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// errCLITokenInKeychain is returned when the Doppler CLI stores its token in the OS keychain, which can't be read
+var errCLITokenInKeychain = errors.New("the Doppler CLI token is stored in the OS keychain")
+
+// The Doppler CLI stores its configuration in ~/.doppler/.doppler.yaml, keyed by the directory each setting is scoped to:
+//
+//	scoped:
+//	    /:
+//	        token: dp.pt.xxxx
+//	    /home/user/project:
+//	        enclave.project: backend
+func getCLIConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".doppler", ".doppler.yaml"), nil
+}
+
+// parseCLIConfigScopes returns the settings for each scope in a Doppler CLI configuration file.
+func parseCLIConfigScopes(contents string) (map[string]map[string]string, error) {
+	var config struct {
+		Scoped map[string]map[string]interface{} `yaml:"scoped"`
+	}
+	if err := yaml.Unmarshal([]byte(contents), &config); err != nil {
+		return nil, err
+	}
+	scopes := map[string]map[string]string{}
+	for scope, values := range config.Scoped {
+		settings := map[string]string{}
+		for key, value := range values {
+			switch value.(type) {
+			case nil, map[string]interface{}, []interface{}:
+				// Only scalar settings are used
+				continue
+			default:
+				settings[key] = fmt.Sprint(value)
+			}
+		}
+		scopes[scope] = settings
+	}
+	return scopes, nil
+}
+
+// getCLIConfigValue returns the value of a setting for the given directory, using the most specific scope which sets it.
+func getCLIConfigValue(scopes map[string]map[string]string, dir string, key string) string {
+	bestScope := ""
+	bestValue := ""
+	found := false
+	for scope, settings := range scopes {
+		value, ok := settings[key]
+		if !ok || value == "" {
+			continue
+		}
+		scopePath := filepath.Clean(scope)
+		if scopePath != string(filepath.Separator) && dir != scopePath && !strings.HasPrefix(dir, scopePath+string(filepath.Separator)) {
+			continue
+		}
+		if !found || len(scopePath) > len(bestScope) {
+			bestScope = scopePath
+			bestValue = value
+			found = true
+		}
+	}
+	return bestValue
+}
+
+// getCLIToken returns the token the Doppler CLI would use in the current directory.
+// Tokens stored in the system keychain are not supported, so errCLITokenInKeychain is returned for them.
+func getCLIToken() (string, error) {
+	path, err := getCLIConfigPath()
+	if err != nil {
+		return "", err
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	scopes, err := parseCLIConfigScopes(string(contents))
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse %s: %w", path, err)
+	}
+	token := getCLIConfigValue(scopes, dir, "token")
+	if token != "" && !strings.HasPrefix(token, "dp.") {
+		// The CLI only stores a reference to the token when it is saved in the keychain
+		return "", errCLITokenInKeychain
+	}
+	return token, nil
+}
@@ -0,0 +1,199 @@
+package doppler
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseCLIConfigScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected map[string]map[string]string
+	}{
+		{
+			name:     "empty",
+			contents: "",
+			expected: map[string]map[string]string{},
+		},
+		{
+			name: "CLI format",
+			contents: `scoped:
+    /:
+        token: dp.pt.root
+        api-host: https://api.doppler.com
+    /home/user/project:
+        enclave.project: backend
+        enclave.config: dev
+`,
+			expected: map[string]map[string]string{
+				"/":                  {"token": "dp.pt.root", "api-host": "https://api.doppler.com"},
+				"/home/user/project": {"enclave.project": "backend", "enclave.config": "dev"},
+			},
+		},
+		{
+			name: "quoted values and comments",
+			contents: `# Doppler CLI configuration
+scoped:
+  "/home/user":
+    token: "dp.pt.quoted"
+
+    # A comment inside a scope
+    enclave.project: 'backend'
+`,
+			expected: map[string]map[string]string{
+				"/home/user": {"token": "dp.pt.quoted", "enclave.project": "backend"},
+			},
+		},
+		{
+			name: "Windows paths",
+			contents: `scoped:
+    C:\:
+        token: dp.pt.windows
+    C:\Users\user\project:
+        enclave.project: backend
+`,
+			expected: map[string]map[string]string{
+				`C:\`:                   {"token": "dp.pt.windows"},
+				`C:\Users\user\project`: {"enclave.project": "backend"},
+			},
+		},
+		{
+			name: "other top-level sections are ignored",
+			contents: `version: 1
+fallback:
+    /:
+        token: dp.pt.fallback
+scoped:
+    /:
+        token: dp.pt.scoped
+analytics:
+    disable: true
+`,
+			expected: map[string]map[string]string{
+				"/": {"token": "dp.pt.scoped"},
+			},
+		},
+		{
+			name:     "flow maps",
+			contents: `scoped: {"/": {token: dp.pt.flow, "enclave.project": backend}}`,
+			expected: map[string]map[string]string{
+				"/": {"token": "dp.pt.flow", "enclave.project": "backend"},
+			},
+		},
+		{
+			name: "multi-line and non-string values",
+			contents: `scoped:
+    /:
+        token: >-
+            dp.pt.folded
+        verify-tls: true
+        nested:
+            key: ignored
+`,
+			expected: map[string]map[string]string{
+				"/": {"token": "dp.pt.folded", "verify-tls": "true"},
+			},
+		},
+		{
+			name: "empty scope",
+			contents: `scoped:
+    /empty:
+    /:
+        token: dp.pt.root
+`,
+			expected: map[string]map[string]string{
+				"/empty": {},
+				"/":      {"token": "dp.pt.root"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scopes, err := parseCLIConfigScopes(test.contents)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(scopes, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, scopes)
+			}
+		})
+	}
+}
+
+func TestParseCLIConfigScopesInvalid(t *testing.T) {
+	if _, err := parseCLIConfigScopes("scoped:\n  /: [unterminated"); err == nil {
+		t.Error("Expected an error for invalid YAML")
+	}
+}
+
+func TestGetCLIConfigValue(t *testing.T) {
+	scopes := map[string]map[string]string{
+		"/":                  {"token": "dp.pt.root"},
+		"/home/user":         {"token": "dp.pt.user", "enclave.project": "frontend"},
+		"/home/user/project": {"token": "", "enclave.project": "backend"},
+	}
+	tests := []struct {
+		name     string
+		dir      string
+		key      string
+		expected string
+	}{
+		{name: "root scope", dir: "/tmp", key: "token", expected: "dp.pt.root"},
+		{name: "exact scope", dir: "/home/user", key: "token", expected: "dp.pt.user"},
+		{name: "most specific scope", dir: "/home/user/project/src", key: "enclave.project", expected: "backend"},
+		{name: "empty values are skipped", dir: "/home/user/project", key: "token", expected: "dp.pt.user"},
+		{name: "sibling with a common prefix", dir: "/home/username", key: "token", expected: "dp.pt.root"},
+		{name: "unset key", dir: "/tmp", key: "enclave.config", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if value := getCLIConfigValue(scopes, test.dir, test.key); value != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, value)
+			}
+		})
+	}
+}
+
+func TestGetCLIToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents *string
+		expected string
+		err      error
+	}{
+		{name: "no config file", contents: nil, expected: ""},
+		{name: "no token", contents: stringPtr("scoped:\n    /:\n        enclave.project: backend\n"), expected: ""},
+		{name: "plaintext token", contents: stringPtr("scoped:\n    /:\n        token: dp.pt.plaintext\n"), expected: "dp.pt.plaintext"},
+		{name: "keychain token", contents: stringPtr("scoped:\n    /:\n        token: keychain-reference\n"), err: errCLITokenInKeychain},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv("USERPROFILE", home)
+			if test.contents != nil {
+				if err := os.MkdirAll(filepath.Join(home, ".doppler"), 0o700); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(home, ".doppler", ".doppler.yaml"), []byte(*test.contents), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			token, err := getCLIToken()
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Expected error %v, got %v", test.err, err)
+			}
+			if token != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, token)
+			}
+		})
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,184 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceActivityLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	userEmail := d.Get("user_email").(string)
+	project := d.Get("project").(string)
+	pageSize := d.Get("page_size").(int)
+
+	var since, until time.Time
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	// Logs are returned newest first. When `since` is set, pages are read until a log older than it is
+	// reached so that the filters are applied to every log in the window. Otherwise, only the most
+	// recent `page_size` logs are scanned.
+	perPage := pageSize
+	if perPage > 100 {
+		perPage = 100
+	}
+	logsList := []map[string]interface{}{}
+	scanned := 0
+	moreLogs := false
+scan:
+	for page := 1; ; page++ {
+		pageLogs, err := client.ListActivityLogs(ctx, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		moreLogs = len(pageLogs) == perPage
+		for _, log := range pageLogs {
+			if since.IsZero() && scanned == pageSize {
+				moreLogs = true
+				break scan
+			}
+			scanned++
+			if !since.IsZero() || !until.IsZero() {
+				createdAt, err := time.Parse(time.RFC3339, log.CreatedAt)
+				if err != nil {
+					return diag.Errorf("Unable to parse activity log timestamp %s", log.CreatedAt)
+				}
+				if !since.IsZero() && createdAt.Before(since) {
+					moreLogs = false
+					break scan
+				}
+				if !until.IsZero() && createdAt.After(until) {
+					continue
+				}
+			}
+			if userEmail != "" && !strings.EqualFold(log.User.Email, userEmail) {
+				continue
+			}
+			if project != "" && log.Project != project {
+				continue
+			}
+			logsList = append(logsList, map[string]interface{}{
+				"id":          log.ID,
+				"text":        log.Text,
+				"created_at":  log.CreatedAt,
+				"project":     log.Project,
+				"environment": log.Environment,
+				"config":      log.Config,
+				"user_email":  log.User.Email,
+			})
+		}
+		if !moreLogs || (since.IsZero() && scanned == pageSize) {
+			break
+		}
+	}
+
+	hasFilters := userEmail != "" || project != "" || !until.IsZero()
+	if since.IsZero() && moreLogs && hasFilters {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Activity logs may be incomplete",
+			Detail:   fmt.Sprintf("Only the %d most recent workplace logs were filtered, so older matching logs are not included. Set `since` to filter every log in a time window, or increase `page_size`.", pageSize),
+		})
+	}
+
+	d.SetId(strings.Join([]string{"activity_logs", userEmail, project, d.Get("since").(string), d.Get("until").(string)}, "."))
+
+	if err := d.Set("list", logsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceActivityLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceActivityLogsRead,
+		Schema: map[string]*schema.Schema{
+			"user_email": {
+				Description: "Only include logs for actions performed by the user with this email address",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"project": {
+				Description: "Only include logs for this project",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"since": {
+				Description:  "Only include logs created at or after this time (RFC 3339)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"until": {
+				Description:  "Only include logs created at or before this time (RFC 3339)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"page_size": {
+				Description:  "The number of most recent workplace logs to filter when `since` is not set. Defaults to `20`. When `since` is set, every log created since then is filtered and this only sets the number of logs requested per page (up to 100).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"list": {
+				Description: "List of activity logs matching the filters, newest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the log",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"text": {
+							Description: "A description of the logged action",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the action occurred",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project": {
+							Description: "The project the action affected, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "The environment the action affected, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"config": {
+							Description: "The config the action affected, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_email": {
+							Description: "The email address of the user who performed the action",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
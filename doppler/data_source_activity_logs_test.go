@@ -0,0 +1,85 @@
+package doppler_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestDataSourceActivityLogsFilters(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+
+	// 250 logs, one per minute, alternating between two projects
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 250; i++ {
+		project := "backend"
+		if i%2 == 1 {
+			project = "frontend"
+		}
+		server.AddActivityLog(doppler.ActivityLog{
+			ID:        fmt.Sprintf("log_%d", i),
+			Text:      "Updated a secret",
+			CreatedAt: start.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+			Project:   project,
+			User:      doppler.WorkplaceUserInfo{Email: "user@example.com"},
+		})
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]interface{}
+		expectedCount int
+		expectWarning bool
+	}{
+		{
+			name:          "most recent logs",
+			config:        map[string]interface{}{},
+			expectedCount: 20,
+		},
+		{
+			name:          "filters only the most recent logs without since",
+			config:        map[string]interface{}{"project": "backend"},
+			expectedCount: 10,
+			expectWarning: true,
+		},
+		{
+			name:          "filters every log when page_size covers them",
+			config:        map[string]interface{}{"project": "backend", "page_size": 1000},
+			expectedCount: 125,
+		},
+		{
+			name:          "filters every log since",
+			config:        map[string]interface{}{"project": "backend", "since": start.Add(10 * time.Minute).Format(time.RFC3339)},
+			expectedCount: 120,
+		},
+		{
+			name: "filters every log in a window",
+			config: map[string]interface{}{
+				"since": start.Add(10 * time.Minute).Format(time.RFC3339),
+				"until": start.Add(19 * time.Minute).Format(time.RFC3339),
+			},
+			expectedCount: 10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, diags := readDataSource(t, p, "doppler_activity_logs", test.config)
+			if diags.HasError() {
+				t.Fatalf("Read failed: %v", diags)
+			}
+			if count := state.Attributes["list.#"]; count != fmt.Sprint(test.expectedCount) {
+				t.Errorf("Expected %d logs, got %s", test.expectedCount, count)
+			}
+			hasWarning := len(diags) > 0 && diags[0].Severity == diag.Warning
+			if hasWarning != test.expectWarning {
+				t.Errorf("Expected warning: %t, got %v", test.expectWarning, diags)
+			}
+		})
+	}
+}
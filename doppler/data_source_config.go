@@ -0,0 +1,110 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	name := d.Get("name").(string)
+
+	config, err := client.GetConfig(ctx, project, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(config.getResourceId())
+
+	if err := d.Set("environment", config.Environment); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("descriptor", fmt.Sprintf("%s.%s", config.Project, config.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("root", config.Root); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("locked", config.Locked); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("inheritable", config.Inheritable); err != nil {
+		return diag.FromErr(err)
+	}
+
+	inherits := []string{}
+	for _, descriptor := range config.Inherits {
+		inherits = append(inherits, fmt.Sprintf("%s.%s", descriptor.Project, descriptor.Config))
+	}
+	if err := d.Set("inherits", inherits); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("created_at", config.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project where the config is located",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the Doppler config",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment": {
+				Description: "The name of the Doppler environment where the config is located",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"descriptor": {
+				Description: "The descriptor (project.config) of the Doppler config",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"root": {
+				Description: "Whether the config is the root config of its environment",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"locked": {
+				Description: "Whether the config is locked, preventing it from being renamed or deleted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"inheritable": {
+				Description: "Whether the config can be inherited by other configs",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"inherits": {
+				Description: "The descriptors (project.config) of the configs that this config inherits from",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Description: "The time the config was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
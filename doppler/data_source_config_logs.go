@@ -0,0 +1,150 @@
+package doppler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceConfigLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	secret := d.Get("secret").(string)
+	pageSize := d.Get("page_size").(int)
+
+	// Logs are returned newest first, so only the most recent `page_size` entries are scanned.
+	perPage := pageSize
+	if perPage > 100 {
+		perPage = 100
+	}
+	logs := []ConfigLog{}
+	for page := 1; len(logs) < pageSize; page++ {
+		pageLogs, err := client.ListConfigLogs(ctx, project, config, PageOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		logs = append(logs, pageLogs...)
+		if len(pageLogs) < perPage {
+			break
+		}
+	}
+	if len(logs) > pageSize {
+		logs = logs[:pageSize]
+	}
+
+	logsList := []map[string]interface{}{}
+	for _, log := range logs {
+		secretNames := log.secretNames()
+		if secret != "" && !containsString(secretNames, secret) {
+			continue
+		}
+		logsList = append(logsList, map[string]interface{}{
+			"id":         log.ID,
+			"text":       log.Text,
+			"created_at": log.CreatedAt,
+			"rollback":   log.Rollback,
+			"user_email": log.User.Email,
+			"user_name":  log.User.Name,
+			"secrets":    secretNames,
+		})
+	}
+
+	d.SetId(strings.Join([]string{project, config, "logs", secret}, "."))
+
+	if err := d.Set("list", logsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceConfigLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigLogsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"secret": {
+				Description: "Only include logs which changed the secret with this name",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"page_size": {
+				Description:  "The number of most recent config logs to retrieve before filters are applied. Defaults to `20`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"list": {
+				Description: "List of config logs (versions) matching the filters, newest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the log, which identifies the version of the config",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"text": {
+							Description: "A description of the change",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the change was made",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rollback": {
+							Description: "Whether the change rolled the config back to an earlier version",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"user_email": {
+							Description: "The email address of the user who made the change",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"user_name": {
+							Description: "The name of the user who made the change",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"secrets": {
+							Description: "The names of the secrets changed. Secret values are not included.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package doppler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceConfigsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	environment := d.Get("environment").(string)
+	kind := d.Get("kind").(string)
+
+	configs, err := client.ListAllConfigs(ctx, project, environment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configsList := []map[string]interface{}{}
+	for _, config := range configs {
+		// The environment is also filtered here in case the API ignores the filter
+		if environment != "" && config.Environment != environment {
+			continue
+		}
+		if (kind == "root" && !config.Root) || (kind == "branch" && config.Root) {
+			continue
+		}
+		configsList = append(configsList, map[string]interface{}{
+			"name":        config.Name,
+			"project":     config.Project,
+			"environment": config.Environment,
+			"descriptor":  strings.Join([]string{config.Project, config.Name}, "."),
+			"root":        config.Root,
+			"locked":      config.Locked,
+			"inheritable": config.Inheritable,
+			"created_at":  config.CreatedAt,
+		})
+	}
+
+	d.SetId(strings.Join([]string{project, environment, kind}, "."))
+
+	if err := d.Set("list", configsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceConfigs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The project to list configs for",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"environment": {
+				Description: "Only list configs in this environment (e.g. `dev`)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"kind": {
+				Description:  "Only list configs of this kind. Either `all` (default), `root` (the root config of each environment), or `branch` (branch configs).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "root", "branch"}, false),
+			},
+			"list": {
+				Description: "List of configs in the project",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the config",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project": {
+							Description: "The project the config belongs to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "The environment the config belongs to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"descriptor": {
+							Description: "The descriptor (project.config) of the config",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"root": {
+							Description: "Whether the config is the root config of its environment",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"locked": {
+							Description: "Whether the config is locked",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"inheritable": {
+							Description: "Whether the config can be inherited by other configs",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the config was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
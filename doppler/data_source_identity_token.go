@@ -0,0 +1,62 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIdentityTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	identity := d.Get("identity_id").(string)
+	oidcToken := d.Get("oidc_token").(string)
+
+	auth, err := client.ExchangeOIDCToken(ctx, identity, oidcToken)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(identity)
+
+	if err := d.Set("token", auth.Token); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expires_at", auth.ExpiresAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceIdentityToken() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityTokenRead,
+		Schema: map[string]*schema.Schema{
+			"identity_id": {
+				Description: "The ID of the service account identity to authenticate as",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"oidc_token": {
+				Description: "The OIDC token (JWT) to exchange for a Doppler token",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Description: "The short-lived Doppler token issued for the identity",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Description: "The datetime at which the Doppler token expires",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
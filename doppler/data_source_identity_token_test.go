@@ -0,0 +1,37 @@
+package doppler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+func TestDataSourceIdentityToken(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	server.AddOIDCIdentity("identity_1", "oidc.jwt")
+	p := newTestProvider(t, server, nil)
+
+	state, diags := readDataSource(t, p, "doppler_identity_token", map[string]interface{}{
+		"identity_id": "identity_1",
+		"oidc_token":  "oidc.jwt",
+	})
+	if diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	if state.Attributes["token"] != dopplertest.Token || state.Attributes["expires_at"] == "" {
+		t.Errorf("Unexpected attributes %v", state.Attributes)
+	}
+	// The provider's own token must not be sent along with the OIDC token
+	if header := server.RequestHeader(http.MethodPost, "/v3/auth/oidc"); header.Get("Authorization") != "" {
+		t.Error("Expected the OIDC exchange to be sent without an Authorization header")
+	}
+
+	if _, diags := readDataSource(t, p, "doppler_identity_token", map[string]interface{}{
+		"identity_id": "identity_1",
+		"oidc_token":  "forged.jwt",
+	}); !diags.HasError() {
+		t.Error("Expected an invalid OIDC token to be rejected")
+	}
+}
@@ -0,0 +1,94 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	me, err := client.GetMe(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(me.Slug)
+	if err := d.Set("slug", me.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", me.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", me.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token_preview", me.TokenPreview); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", me.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_seen_at", me.LastSeenAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("workplace_slug", me.Workplace.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("workplace_name", me.Workplace.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceMe() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMeRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description: "The slug of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of the token (e.g. `personal`, `cli`, `service_token`, `service_account`)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token_preview": {
+				Description: "A truncated preview of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the token was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_seen_at": {
+				Description: "When the token was last used",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workplace_slug": {
+				Description: "The slug of the workplace the token belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workplace_name": {
+				Description: "The name of the workplace the token belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package doppler_test
+
+import (
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+func TestDataSourceMe(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+
+	state, diags := readDataSource(t, p, "doppler_me", map[string]interface{}{})
+	if diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	expected := map[string]string{
+		"id":             "dopplertest",
+		"slug":           "dopplertest",
+		"type":           "personal",
+		"workplace_slug": "workplace_1",
+		"workplace_name": "Doppler Test",
+	}
+	for key, value := range expected {
+		if state.Attributes[key] != value {
+			t.Errorf("Expected %s to be %q, got %q", key, value, state.Attributes[key])
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProjectRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	roles, err := client.ListProjectRoles(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("project_roles")
+
+	var rolesList []map[string]interface{}
+	for _, role := range roles {
+		roleMap := map[string]interface{}{
+			"identifier":     role.Identifier,
+			"name":           role.Name,
+			"permissions":    role.Permissions,
+			"is_custom_role": role.IsCustomRole,
+			"created_at":     role.CreatedAt,
+		}
+		rolesList = append(rolesList, roleMap)
+	}
+
+	if err := d.Set("list", rolesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceProjectRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectRolesRead,
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Description: "List of project roles, including built-in and custom roles",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Description: "The identifier of the role, used to assign the role to project members",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"permissions": {
+							Description: "The permissions granted by the role",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"is_custom_role": {
+							Description: "Whether the role is a custom role",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the role was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRotatedSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	slug := d.Get("slug").(string)
+
+	rotatedSecret, err := client.GetRotatedSecret(ctx, config, project, slug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getSecretId(project, config, slug))
+
+	if err := d.Set("name", rotatedSecret.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("integration", rotatedSecret.Integration.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rotation_period_sec", rotatedSecret.RotationPeriodSec); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", rotatedSecret.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	lastRotatedAt := ""
+	if rotatedSecret.LastRotatedAt != nil {
+		lastRotatedAt = *rotatedSecret.LastRotatedAt
+	}
+	if err := d.Set("last_rotated_at", lastRotatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("next_rotation_at", rotatedSecret.nextRotationAt()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceRotatedSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRotatedSecretRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"slug": {
+				Description: "The slug of the rotated secret",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the rotated secret",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"integration": {
+				Description: "The slug of the integration used to rotate the secret",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rotation_period_sec": {
+				Description: "How frequently the secret is rotated, in seconds",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The current rotation status of the secret",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_rotated_at": {
+				Description: "The time the secret was last rotated. Empty if the secret has not been rotated yet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"next_rotation_at": {
+				Description: "The time the secret is next due to be rotated. Empty if the secret has not been rotated yet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := getAPIClient(d, m)
+
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	name := d.Get("name").(string)
+
+	d.SetId(getSecretId(project, config, name))
+
+	// `default = ""` is a valid default, so check whether it was configured rather than using GetOk
+	hasDefault := !d.GetRawConfig().GetAttr("default").IsNull()
+
+	secret, err := client.GetSecret(ctx, project, config, name)
+	if err != nil {
+		if !hasDefault || !isNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("value", d.Get("default").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("found", false); err != nil {
+			return diag.FromErr(err)
+		}
+		return diags
+	}
+
+	if secret.Value.Computed == nil {
+		return diag.Errorf("The value of secret %s is restricted. You must use a service account or service token to read restricted secrets.", name)
+	}
+
+	if err := d.Set("value", *secret.Value.Computed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("found", true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecretRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project (required for personal tokens)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"config": {
+				Description: "The name of the Doppler config (required for personal tokens)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"name": {
+				Description: "The name of the Doppler secret",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"default": {
+				Description: "The value to return if the secret does not exist. If not set, a missing secret is an error.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token": tokenOverrideSchema(),
+			"value": {
+				Description: "The computed secret value, or `default` if the secret does not exist",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"found": {
+				Description: "Whether the secret exists in the config",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
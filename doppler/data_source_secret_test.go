@@ -0,0 +1,60 @@
+package doppler_test
+
+import (
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+func TestDataSourceSecretDefault(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+	if _, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one")); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	tests := []struct {
+		name          string
+		secret        string
+		defaultValue  *string
+		expectError   bool
+		expectedValue string
+		expectedFound string
+	}{
+		{name: "existing secret", secret: "API_KEY", expectedValue: "one", expectedFound: "true"},
+		{name: "existing secret ignores default", secret: "API_KEY", defaultValue: stringPtr("fallback"), expectedValue: "one", expectedFound: "true"},
+		{name: "missing secret without default", secret: "MISSING", expectError: true},
+		{name: "missing secret with default", secret: "MISSING", defaultValue: stringPtr("fallback"), expectedValue: "fallback", expectedFound: "false"},
+		{name: "missing secret with empty default", secret: "MISSING", defaultValue: stringPtr(""), expectedValue: "", expectedFound: "false"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]interface{}{"project": "backend", "config": "dev", "name": test.secret}
+			if test.defaultValue != nil {
+				config["default"] = *test.defaultValue
+			}
+			state, diags := readDataSource(t, p, "doppler_secret", config)
+			if test.expectError {
+				if !diags.HasError() {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Read failed: %v", diags)
+			}
+			if value := state.Attributes["value"]; value != test.expectedValue {
+				t.Errorf("Expected value %q, got %q", test.expectedValue, value)
+			}
+			if found := state.Attributes["found"]; found != test.expectedFound {
+				t.Errorf("Expected found %q, got %q", test.expectedFound, found)
+			}
+		})
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
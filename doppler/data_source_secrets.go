@@ -9,31 +9,90 @@ import (
 
 func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(APIClient)
+	client := getAPIClient(d, m)
 
 	project := d.Get("project").(string)
 	config := d.Get("config").(string)
 
 	d.SetId(getSecretsId(project, config))
 
-	result, err := client.GetComputedSecrets(ctx, project, config)
+	secretsMetadata, err := client.GetSecrets(ctx, project, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	secrets := make(map[string]string)
+	visibilities := make(map[string]string)
+	notes := make(map[string]string)
+	details := make([]interface{}, 0, len(secretsMetadata))
+	missingValues := false
+	for _, secret := range secretsMetadata {
+		details = append(details, flattenSecretDetails(secret))
+		if secret.Value.Computed != nil {
+			secrets[secret.Name] = *secret.Value.Computed
+		} else {
+			missingValues = true
+		}
+		if secret.Value.RawVisibility != nil {
+			visibilities[secret.Name] = *secret.Value.RawVisibility
+		}
+		if secret.Value.Note != nil && *secret.Value.Note != "" {
+			notes[secret.Name] = *secret.Value.Note
+		}
+	}
 
-	for _, secret := range result {
-		secrets[secret.Name] = secret.Value
+	// Values which aren't listed (e.g. restricted secrets) are read from the download instead
+	if missingValues {
+		result, err := client.GetComputedSecrets(ctx, project, config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, secret := range result {
+			secrets[secret.Name] = secret.Value
+		}
 	}
 
 	if err := d.Set("map", secrets); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("visibility", visibilities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("notes", notes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("secrets", details); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
+func flattenSecretDetails(secret Secret) map[string]interface{} {
+	details := map[string]interface{}{
+		"name": secret.Name,
+	}
+	if secret.Value.Raw != nil {
+		details["raw"] = *secret.Value.Raw
+	}
+	if secret.Value.Computed != nil {
+		details["computed"] = *secret.Value.Computed
+	}
+	if secret.Value.RawVisibility != nil {
+		details["visibility"] = *secret.Value.RawVisibility
+	}
+	if secret.Value.Note != nil {
+		details["note"] = *secret.Value.Note
+	}
+	if secret.Value.RawValueType != nil {
+		details["value_type"] = secret.Value.RawValueType.Type
+	}
+	return details
+}
+
 func dataSourceSecrets() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceSecretsRead,
@@ -50,6 +109,64 @@ func dataSourceSecrets() *schema.Resource {
 				Optional:    true,
 				Default:     "",
 			},
+			"visibility": {
+				Description: "A mapping of secret names to their visibility (`masked`, `unmasked`, or `restricted`)",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"notes": {
+				Description: "A mapping of secret names to their notes. Secrets without a note are omitted.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"secrets": {
+				Description: "The secrets in the config along with their metadata, sorted by name. Use a `for` expression to build a map keyed by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"raw": {
+							Description: "The raw secret value. Empty for restricted secrets.",
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"computed": {
+							Description: "The computed secret value, after resolving secret references. Empty for restricted secrets.",
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"visibility": {
+							Description: "The visibility of the secret (`masked`, `unmasked`, or `restricted`)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"note": {
+							Description: "The note describing the secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value_type": {
+							Description: "The value type of the secret",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"token": tokenOverrideSchema(),
 			"map": {
 				Description: "A mapping of secret names to computed secret values",
 				Type:        schema.TypeMap,
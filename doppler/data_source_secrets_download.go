@@ -0,0 +1,76 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var SecretsDownloadFormats = []string{"json", "env", "env-no-quotes", "docker", "yaml", "dotnet-json"}
+
+func dataSourceSecretsDownloadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := getAPIClient(d, m)
+
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	format := d.Get("format").(string)
+	nameTransformer := d.Get("name_transformer").(string)
+
+	content, err := client.DownloadSecrets(ctx, project, config, format, nameTransformer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{getSecretsId(project, config), format}, "."))
+
+	if err := d.Set("content", content); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceSecretsDownload() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecretsDownloadRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project (required for personal tokens)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"config": {
+				Description: "The name of the Doppler config (required for personal tokens)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"format": {
+				Description:  fmt.Sprintf("The format to render the secrets in. Valid formats: %s. Defaults to `json`.", strings.Join(SecretsDownloadFormats, ", ")),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice(SecretsDownloadFormats, false),
+			},
+			"name_transformer": {
+				Description:  fmt.Sprintf("An optional transformer applied to secret names. Valid transformers: %s", strings.Join(NameTransformers, ", ")),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(NameTransformers, false),
+			},
+			"token": tokenOverrideSchema(),
+			"content": {
+				Description: "The config's secrets rendered in the requested format",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package doppler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+func TestDataSourceSecretsReadsSecretsOnce(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	apiKey, databaseURL := "one", "postgres://localhost"
+	changes := []doppler.ChangeRequest{
+		{Name: "API_KEY", Value: &apiKey, Visibility: "restricted"},
+		{Name: "DATABASE_URL", Value: &databaseURL, Visibility: "unmasked"},
+	}
+	if err := testClient(p).UpdateSecrets(t.Context(), "backend", "dev", changes); err != nil {
+		t.Fatal(err)
+	}
+	if err := testClient(p).UpdateSecretNote(t.Context(), "backend", "dev", "API_KEY", "Issued by the payments team"); err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := readDataSource(t, p, "doppler_secrets", map[string]interface{}{"project": "backend", "config": "dev"})
+	if diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	expected := map[string]string{
+		"map.API_KEY":             "one",
+		"map.DATABASE_URL":        "postgres://localhost",
+		"visibility.API_KEY":      "restricted",
+		"visibility.DATABASE_URL": "unmasked",
+		"notes.%":                 "1",
+		"notes.API_KEY":           "Issued by the payments team",
+		"secrets.#":               "2",
+		"secrets.0.name":          "API_KEY",
+		"secrets.1.computed":      "postgres://localhost",
+	}
+	for key, value := range expected {
+		if state.Attributes[key] != value {
+			t.Errorf("Expected %s to be %q, got %q", key, value, state.Attributes[key])
+		}
+	}
+
+	// The values, visibility, and notes all come from a single list of the secrets
+	if requests := server.Requests(http.MethodGet, secretsPath); requests != 1 {
+		t.Errorf("Expected a single request to list secrets, got %d", requests)
+	}
+	if requests := server.Requests(http.MethodGet, secretsPath+"/download"); requests != 0 {
+		t.Errorf("Expected the secrets not to be downloaded, got %d requests", requests)
+	}
+}
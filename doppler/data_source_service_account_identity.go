@@ -0,0 +1,103 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountIdentityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	serviceAccountSlug := d.Get("service_account_slug").(string)
+	slug := d.Get("slug").(string)
+
+	id, err := client.GetServiceAccountIdentity(ctx, serviceAccountSlug, slug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = updateServiceAccountIdentityState(d, serviceAccountSlug, &id, diags)
+	return diags
+}
+
+func dataSourceServiceAccountIdentity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceAccountIdentityRead,
+		Schema: map[string]*schema.Schema{
+			"service_account_slug": {
+				Description: "Slug of the service account",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"slug": {
+				Description: "Slug of the service account identity",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The display name of the service account identity",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ttl_seconds": {
+				Description: "The amount of time, in seconds, that auth tokens for this identity will be valid",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The datetime that the identity was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_authenticated_at": {
+				Description: "The datetime that a token was last issued for the identity. Empty if the identity has never been used.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"config_oidc": {
+				Description: "The OIDC configuration for the identity",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"discovery_url": {
+							Description: "The public URL of the OpenID discovery service",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"claims_type": {
+							Description: "If \"wildcard\", wildcard characters will be expanded during claims validation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"claims": {
+							Description: "A set of valid values for a specific claim",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The key of the claim to validate",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"values": {
+										Description: "The set of valid values for this claim",
+										Type:        schema.TypeSet,
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
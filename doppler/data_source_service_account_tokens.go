@@ -0,0 +1,87 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountTokensRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	serviceAccount := d.Get("service_account_slug").(string)
+
+	tokens, err := client.ListAllServiceAccountTokens(ctx, serviceAccount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serviceAccount)
+
+	var tokensList []map[string]interface{}
+	for _, token := range tokens {
+		tokenMap := map[string]interface{}{
+			"slug":         token.Slug,
+			"name":         token.Name,
+			"created_at":   token.CreatedAt,
+			"expires_at":   token.ExpiresAt,
+			"last_seen_at": token.LastSeenAt,
+		}
+		tokensList = append(tokensList, tokenMap)
+	}
+
+	if err := d.Set("list", tokensList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceServiceAccountTokens() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceAccountTokensRead,
+		Schema: map[string]*schema.Schema{
+			"service_account_slug": {
+				Description: "Slug of the service account",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"list": {
+				Description: "List of API tokens for the service account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the API token",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The display name of the API token",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The datetime that the token was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "The datetime at which the token expires. Empty if the token does not expire.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_seen_at": {
+							Description: "The datetime that the token was last used. Empty if the token has never been used.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
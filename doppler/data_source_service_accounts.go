@@ -0,0 +1,74 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	serviceAccounts, err := client.ListAllServiceAccounts(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("service_accounts")
+
+	var serviceAccountsList []map[string]interface{}
+	for _, serviceAccount := range serviceAccounts {
+		serviceAccountMap := map[string]interface{}{
+			"slug":           serviceAccount.Slug,
+			"name":           serviceAccount.Name,
+			"workplace_role": serviceAccount.WorkplaceRole.Identifier,
+			"created_at":     serviceAccount.CreatedAt,
+		}
+		serviceAccountsList = append(serviceAccountsList, serviceAccountMap)
+	}
+
+	if err := d.Set("list", serviceAccountsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Description: "List of service accounts in the workplace",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The slug of the service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"workplace_role": {
+							Description: "The identifier of the service account's workplace role. Empty if the service account uses custom permissions.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "When the service account was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -20,6 +20,18 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err := d.Set("slug", result.Slug); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("name", result.User.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", result.User.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access", result.Access); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", result.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -38,6 +50,26 @@ func dataSourceUser() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"name": {
+				Description: "The name of the Doppler user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the Doppler user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"access": {
+				Description: "The workplace access level of the Doppler user (e.g. `owner`, `admin`, `collaborator`, `viewer`, `no_access`)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time the user was added to the workplace",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
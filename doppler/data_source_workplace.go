@@ -0,0 +1,62 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWorkplaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(APIClient)
+
+	workplace, err := client.GetWorkplace(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workplace.ID)
+	if err := d.Set("slug", workplace.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", workplace.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("billing_email", workplace.BillingEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("security_email", workplace.SecurityEmail); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceWorkplace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkplaceRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Description: "The slug of the workplace",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the workplace",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"billing_email": {
+				Description: "The email address that receives billing notifications",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"security_email": {
+				Description: "The email address that receives security notifications",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
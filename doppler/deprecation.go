@@ -0,0 +1,130 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type deprecationCollectorKey struct{}
+
+// deprecationCollector gathers the deprecation notices returned by the API during a single resource operation
+type deprecationCollector struct {
+	mu      sync.Mutex
+	notices []string
+}
+
+func (c *deprecationCollector) add(notice string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, existing := range c.notices {
+		if existing == notice {
+			return
+		}
+	}
+	c.notices = append(c.notices, notice)
+}
+
+// deprecationWarnings tracks the notices which have already been reported, so each notice
+// is only reported once per resource type. It is shared by copies of the client.
+type deprecationWarnings struct {
+	mu       sync.Mutex
+	reported map[string]bool
+}
+
+func newDeprecationWarnings() *deprecationWarnings {
+	return &deprecationWarnings{reported: map[string]bool{}}
+}
+
+func (w *deprecationWarnings) diagnostics(resourceType string, notices []string) diag.Diagnostics {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var diags diag.Diagnostics
+	for _, notice := range notices {
+		key := resourceType + "\x00" + notice
+		if w.reported[key] {
+			continue
+		}
+		w.reported[key] = true
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Doppler API deprecation",
+			Detail:   notice + "\n\nUpgrade the Doppler provider, or report the issue if this warning persists on the latest version.",
+		})
+	}
+	return diags
+}
+
+// recordDeprecationNotices records the deprecation notices of an API response in the operation's collector, if any.
+// Notices are read from the `Deprecation`, `Sunset`, and `Warning` headers and from a `warnings` field in JSON bodies.
+func recordDeprecationNotices(ctx context.Context, method string, path string, response *APIResponse, err error) {
+	collector, ok := ctx.Value(deprecationCollectorKey{}).(*deprecationCollector)
+	if !ok {
+		return
+	}
+	if apiError, ok := err.(*APIError); ok && response == nil {
+		response = apiError.Response
+	}
+	if response == nil || response.HTTPResponse == nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s %s", method, path)
+	headers := response.HTTPResponse.Header
+	deprecation := headers.Get("Deprecation")
+	sunset := headers.Get("Sunset")
+	if deprecation != "" || sunset != "" {
+		notice := fmt.Sprintf("The API endpoint %s is deprecated.", endpoint)
+		if sunset != "" {
+			notice = fmt.Sprintf("The API endpoint %s is deprecated and will be removed after %s.", endpoint, sunset)
+		}
+		collector.add(notice)
+	}
+	for _, warning := range headers.Values("Warning") {
+		collector.add(fmt.Sprintf("The API endpoint %s returned a warning: %s", endpoint, warning))
+	}
+
+	var body struct {
+		Warnings []string `json:"warnings"`
+	}
+	if strings.HasPrefix(headers.Get("content-type"), "application/json") && json.Unmarshal(response.Body, &body) == nil {
+		for _, warning := range body.Warnings {
+			collector.add(fmt.Sprintf("The API endpoint %s returned a warning: %s", endpoint, warning))
+		}
+	}
+}
+
+type contextOperationFunc = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics
+
+// withDeprecationWarnings wraps a resource operation to report the API's deprecation notices as warnings
+func withDeprecationWarnings(resourceType string, operation contextOperationFunc) contextOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		collector := &deprecationCollector{}
+		diags := operation(context.WithValue(ctx, deprecationCollectorKey{}, collector), d, m)
+		if client, ok := m.(APIClient); ok && client.deprecationWarnings != nil && len(collector.notices) > 0 {
+			diags = append(diags, client.deprecationWarnings.diagnostics(resourceType, collector.notices)...)
+		}
+		return diags
+	}
+}
+
+// addDeprecationWarnings reports the API's deprecation notices as warnings from each of the resource's operations
+func addDeprecationWarnings(resourceType string, resource *schema.Resource) {
+	if resource.CreateContext != nil {
+		resource.CreateContext = withDeprecationWarnings(resourceType, resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withDeprecationWarnings(resourceType, resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withDeprecationWarnings(resourceType, resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = withDeprecationWarnings(resourceType, resource.DeleteContext)
+	}
+}
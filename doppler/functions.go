@@ -0,0 +1,223 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type providerFunctionImpl = func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError)
+
+type providerFunction struct {
+	Definition *tfprotov5.Function
+	Impl       providerFunctionImpl
+}
+
+// providerFunctions returns the provider-defined functions, keyed by name.
+// The SDK doesn't support functions, so they're served by wrapping the SDK's provider server.
+func providerFunctions() map[string]providerFunction {
+	return map[string]providerFunction{
+		"secret_ref":     functionSecretRef(),
+		"normalize_name": functionNormalizeName(),
+	}
+}
+
+// NewProviderServer returns the provider server, including the provider-defined functions.
+func NewProviderServer() tfprotov5.ProviderServer {
+	return &providerServer{
+		ProviderServer: schema.NewGRPCProviderServer(Provider()),
+		functions:      providerFunctions(),
+	}
+}
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+	functions map[string]providerFunction
+}
+
+func (s *providerServer) functionDefinitions() map[string]*tfprotov5.Function {
+	definitions := make(map[string]*tfprotov5.Function, len(s.functions))
+	for name, function := range s.functions {
+		definitions[name] = function.Definition
+	}
+	return definitions
+}
+
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	for name := range s.functions {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+	return resp, nil
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Functions = s.functionDefinitions()
+	return resp, nil
+}
+
+func (s *providerServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{Functions: s.functionDefinitions()}, nil
+}
+
+func (s *providerServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	function, ok := s.functions[req.Name]
+	if !ok {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{Text: fmt.Sprintf("Unknown function %q", req.Name)},
+		}, nil
+	}
+
+	parameters := function.Definition.Parameters
+	if len(req.Arguments) != len(parameters) {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{Text: fmt.Sprintf("Expected %d arguments, got %d", len(parameters), len(req.Arguments))},
+		}, nil
+	}
+
+	args := make([]tftypes.Value, len(req.Arguments))
+	for i, argument := range req.Arguments {
+		value, err := argument.Unmarshal(parameters[i].Type)
+		if err != nil {
+			return &tfprotov5.CallFunctionResponse{
+				Error: functionArgumentError(i, fmt.Sprintf("Unable to read argument: %s", err)),
+			}, nil
+		}
+		args[i] = value
+	}
+
+	result, funcErr := function.Impl(args)
+	if funcErr != nil {
+		return &tfprotov5.CallFunctionResponse{Error: funcErr}, nil
+	}
+
+	dynamicResult, err := tfprotov5.NewDynamicValue(function.Definition.Return.Type, result)
+	if err != nil {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{Text: fmt.Sprintf("Unable to encode result: %s", err)},
+		}, nil
+	}
+	return &tfprotov5.CallFunctionResponse{Result: &dynamicResult}, nil
+}
+
+func functionArgumentError(index int, text string) *tfprotov5.FunctionError {
+	argument := int64(index)
+	return &tfprotov5.FunctionError{Text: text, FunctionArgument: &argument}
+}
+
+// functionStringArgs converts function arguments to strings. Parameters don't allow null values, so every argument is known.
+func functionStringArgs(args []tftypes.Value) ([]string, *tfprotov5.FunctionError) {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		if err := arg.As(&strs[i]); err != nil {
+			return nil, functionArgumentError(i, fmt.Sprintf("Expected a string: %s", err))
+		}
+	}
+	return strs, nil
+}
+
+var (
+	secretReferenceScopeRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	secretReferenceNameRegex  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+func functionSecretRef() providerFunction {
+	return providerFunction{
+		Definition: &tfprotov5.Function{
+			Summary:     "Build a Doppler secret reference",
+			Description: "Returns a secret reference (e.g. `${project.config.SECRET_NAME}`) for use in a Doppler secret value. Pass an empty `project` to reference a secret in the same project, or an empty `project` and `config` to reference a secret in the same config.",
+			Parameters: []*tfprotov5.FunctionParameter{
+				{Name: "project", Type: tftypes.String, Description: "The name of the Doppler project, or an empty string for the same project"},
+				{Name: "config", Type: tftypes.String, Description: "The name of the Doppler config, or an empty string for the same config"},
+				{Name: "name", Type: tftypes.String, Description: "The name of the secret"},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+		Impl: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			strs, funcErr := functionStringArgs(args)
+			if funcErr != nil {
+				return tftypes.Value{}, funcErr
+			}
+			project, config, name := strs[0], strs[1], strs[2]
+
+			if project != "" && !secretReferenceScopeRegex.MatchString(project) {
+				return tftypes.Value{}, functionArgumentError(0, fmt.Sprintf("Invalid project name %q. Project names may only contain letters, numbers, dashes, and underscores.", project))
+			}
+			if project != "" && config == "" {
+				return tftypes.Value{}, functionArgumentError(1, "A config is required when referencing a secret in another project.")
+			}
+			if config != "" && !secretReferenceScopeRegex.MatchString(config) {
+				return tftypes.Value{}, functionArgumentError(1, fmt.Sprintf("Invalid config name %q. Config names may only contain letters, numbers, dashes, and underscores.", config))
+			}
+			if !secretReferenceNameRegex.MatchString(name) {
+				return tftypes.Value{}, functionArgumentError(2, fmt.Sprintf("Invalid secret name %q. Secret names may only contain letters, numbers, and underscores, and cannot start with a number.", name))
+			}
+
+			reference := name
+			if config != "" {
+				reference = config + "." + reference
+			}
+			if project != "" {
+				reference = project + "." + reference
+			}
+			return tftypes.NewValue(tftypes.String, "${"+reference+"}"), nil
+		},
+	}
+}
+
+var secretNameInvalidCharsRegex = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// normalizeSecretName converts an arbitrary string to an UPPER_SNAKE secret name,
+// e.g. `my-db password` becomes `MY_DB_PASSWORD`. The result always passes `secretNameProblem`
+// unless it is empty or too long.
+func normalizeSecretName(value string) string {
+	name := secretNameInvalidCharsRegex.ReplaceAllString(strings.ToUpper(value), "_")
+	name = strings.Trim(name, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	// Keep clear of the prefix reserved for the secrets Doppler provides, e.g. `doppler-url` becomes `_DOPPLER_URL`
+	if strings.HasPrefix(name, reservedSecretNamePrefix) {
+		name = "_" + name
+	}
+	return name
+}
+
+func functionNormalizeName() providerFunction {
+	return providerFunction{
+		Definition: &tfprotov5.Function{
+			Summary:     "Normalize a string into a valid secret name",
+			Description: "Returns the value converted to a valid UPPER_SNAKE secret name. Letters are uppercased, runs of other characters are replaced with an underscore, and names that would start with a number or the reserved `DOPPLER_` prefix are prefixed with an underscore.",
+			Parameters: []*tfprotov5.FunctionParameter{
+				{Name: "value", Type: tftypes.String, Description: "The string to normalize"},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+		Impl: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			strs, funcErr := functionStringArgs(args)
+			if funcErr != nil {
+				return tftypes.Value{}, funcErr
+			}
+			name := normalizeSecretName(strs[0])
+			if name == "" {
+				return tftypes.Value{}, functionArgumentError(0, fmt.Sprintf("Unable to normalize %q into a secret name. The value must contain at least one letter or number.", strs[0]))
+			}
+			if len(name) > maxSecretNameLength {
+				return tftypes.Value{}, functionArgumentError(0, fmt.Sprintf("Unable to normalize %q into a secret name. Secret names may be at most %d characters.", strs[0], maxSecretNameLength))
+			}
+			return tftypes.NewValue(tftypes.String, name), nil
+		},
+	}
+}
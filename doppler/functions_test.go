@@ -0,0 +1,37 @@
+package doppler
+
+import "testing"
+
+func TestNormalizeSecretName(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "API_KEY", expected: "API_KEY"},
+		{value: "my-db password", expected: "MY_DB_PASSWORD"},
+		{value: "  orders--db  ", expected: "ORDERS_DB"},
+		{value: "café", expected: "CAF"},
+		{value: "2fa-secret", expected: "_2FA_SECRET"},
+		{value: "doppler-x", expected: "_DOPPLER_X"},
+		{value: "Doppler_URL", expected: "_DOPPLER_URL"},
+		{value: "doppler", expected: "DOPPLER"},
+		{value: "dopplerx", expected: "DOPPLERX"},
+		{value: "my doppler token", expected: "MY_DOPPLER_TOKEN"},
+		{value: "---", expected: ""},
+		{value: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			name := normalizeSecretName(test.value)
+			if name != test.expected {
+				t.Errorf("normalizeSecretName(%q) = %q, expected %q", test.value, name, test.expected)
+			}
+			// Every non-empty result must be accepted by the secret name validation
+			if name != "" {
+				if problem := secretNameProblem(name); problem != "" {
+					t.Errorf("normalizeSecretName(%q) = %q, which is invalid: %s", test.value, name, problem)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "[REDACTED]"
+
+// Keys whose values are always redacted from logged bodies, wherever they appear
+var sensitiveBodyKeys = map[string]bool{
+	"raw":               true,
+	"computed":          true,
+	"value":             true,
+	"originalvalue":     true,
+	"token":             true,
+	"api_key":           true,
+	"key":               true,
+	"password":          true,
+	"secret":            true,
+	"secret_access_key": true,
+	"credentials":       true,
+	"url":               true,
+	"authenticated_url": true,
+	// Config log diffs include the previous and new secret values
+	"added":   true,
+	"removed": true,
+}
+
+// Keys whose nested values are all redacted, e.g. the secrets map (keyed by secret name) of a config
+var sensitiveContainerKeys = map[string]bool{
+	"secrets": true,
+	"data":    true,
+}
+
+// Keys which are never redacted within a sensitive container since they only describe a value
+var metadataBodyKeys = map[string]bool{
+	"name":               true,
+	"note":               true,
+	"rawvisibility":      true,
+	"computedvisibility": true,
+	"rawvaluetype":       true,
+	"computedvaluetype":  true,
+	"type":               true,
+}
+
+// redactBody returns a copy of a JSON body which is safe to log, optionally redacting every value.
+// Non-JSON bodies (e.g. secrets downloaded in the `env` format) are omitted entirely.
+func redactBody(body []byte, redactAll bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return fmt.Sprintf("[REDACTED non-JSON body, %d bytes]", len(body))
+	}
+	redacted, err := json.Marshal(redactJSONValue(parsed, redactAll))
+	if err != nil {
+		return fmt.Sprintf("[REDACTED body, %d bytes]", len(body))
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value interface{}, redactAll bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			lowerKey := strings.ToLower(key)
+			switch {
+			case sensitiveBodyKeys[lowerKey]:
+				result[key] = redactLeaf(nested)
+			case redactAll && metadataBodyKeys[lowerKey]:
+				result[key] = nested
+			default:
+				result[key] = redactJSONValue(nested, redactAll || sensitiveContainerKeys[lowerKey])
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, nested := range v {
+			result[i] = redactJSONValue(nested, redactAll)
+		}
+		return result
+	default:
+		if redactAll {
+			return redactLeaf(v)
+		}
+		return v
+	}
+}
+
+func redactLeaf(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return redactedValue
+}
+
+// redactHeaders returns the headers in a form which is safe to log
+func redactHeaders(headers http.Header) map[string]string {
+	result := map[string]string{}
+	for key, values := range headers {
+		switch strings.ToLower(key) {
+		case "authorization", "cookie", "set-cookie":
+			result[key] = redactedValue
+		default:
+			result[key] = strings.Join(values, ", ")
+		}
+	}
+	return result
+}
+
+// logHTTPExchange logs the request and response bodies of an API request with secret values, tokens, and
+// credentials redacted. It is only called when `debug_http` is enabled.
+func logHTTPExchange(ctx context.Context, req *http.Request, body []byte, response *APIResponse, err error) {
+	if apiError, ok := err.(*APIError); ok && response == nil {
+		response = apiError.Response
+	}
+	// Downloaded secrets are a flat map of secret names to values
+	isDownload := strings.HasSuffix(req.URL.Path, "/secrets/download")
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"path":            req.URL.Path,
+		"request_headers": redactHeaders(req.Header),
+		"request_body":    redactBody(body, false),
+	}
+	if response != nil && response.HTTPResponse != nil {
+		fields["status_code"] = response.HTTPResponse.StatusCode
+		fields["response_headers"] = redactHeaders(response.HTTPResponse.Header)
+		fields["response_body"] = redactBody(response.Body, isDownload && isSuccess(response.HTTPResponse.StatusCode))
+	}
+	tflog.Debug(ctx, "Doppler API HTTP exchange", fields)
+}
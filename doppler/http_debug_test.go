@@ -0,0 +1,78 @@
+package doppler
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		redactAll bool
+		expected  string
+	}{
+		{name: "empty", body: "", expected: ""},
+		{name: "non-JSON", body: "API_KEY=secret\n", expected: "[REDACTED non-JSON body, 15 bytes]"},
+		{
+			name:     "sensitive keys",
+			body:     `{"name":"API_KEY","value":{"raw":"secret","computed":"secret"},"token":"dp.st.xxx","success":true}`,
+			expected: `{"name":"API_KEY","success":true,"token":"[REDACTED]","value":"[REDACTED]"}`,
+		},
+		{
+			name:     "sensitive keys are case-insensitive",
+			body:     `{"Password":"hunter2","originalValue":"old"}`,
+			expected: `{"Password":"[REDACTED]","originalValue":"[REDACTED]"}`,
+		},
+		{
+			name:     "null values are kept",
+			body:     `{"value":null}`,
+			expected: `{"value":null}`,
+		},
+		{
+			name:     "secrets container keeps metadata",
+			body:     `{"secrets":{"DATABASE_HOST":{"raw":"secret","rawVisibility":"masked","note":"A note"}},"page":1}`,
+			expected: `{"page":1,"secrets":{"DATABASE_HOST":{"note":"A note","raw":"[REDACTED]","rawVisibility":"masked"}}}`,
+		},
+		{
+			name:     "arrays",
+			body:     `{"secrets":[{"name":"API_KEY","value":"secret"},{"name":"DB_URL","value":"postgres://"}]}`,
+			expected: `{"secrets":[{"name":"API_KEY","value":"[REDACTED]"},{"name":"DB_URL","value":"[REDACTED]"}]}`,
+		},
+		{
+			name:     "sync data",
+			body:     `{"sync":{"slug":"sync_1","data":{"region":"us-east-1","path":"/app/"}}}`,
+			expected: `{"sync":{"data":{"path":"[REDACTED]","region":"[REDACTED]"},"slug":"sync_1"}}`,
+		},
+		{
+			name:      "redact all",
+			body:      `{"API_KEY":"secret","DB_URL":"postgres://","count":2}`,
+			redactAll: true,
+			expected:  `{"API_KEY":"[REDACTED]","DB_URL":"[REDACTED]","count":"[REDACTED]"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if redacted := redactBody([]byte(test.body), test.redactAll); redacted != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, redacted)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization": []string{"Basic ZHAuc3QueHh4Og=="},
+		"Set-Cookie":    []string{"session=abc"},
+		"Accept":        []string{"application/json", "text/plain"},
+	}
+	expected := map[string]string{
+		"Authorization": "[REDACTED]",
+		"Set-Cookie":    "[REDACTED]",
+		"Accept":        "application/json, text/plain",
+	}
+	if redacted := redactHeaders(headers); !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("Expected %v, got %v", expected, redacted)
+	}
+}
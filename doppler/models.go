@@ -3,8 +3,10 @@ package doppler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
+	"time"
 )
 
 type ComputedSecret struct {
@@ -42,12 +44,25 @@ type SecretValue struct {
 	ComputedVisibility *string    `json:"computedVisibility,omitempty"`
 	RawValueType       *ValueType `json:"rawValueType,omitempty"`
 	ComputedValueType  *ValueType `json:"computedValueType,omitempty"`
+	Note               *string    `json:"note,omitempty"`
+}
+
+type SecretsResponse struct {
+	Secrets map[string]SecretValue `json:"secrets"`
 }
 
 func getSecretsId(project string, config string) string {
 	return strings.Join([]string{project, config}, ".")
 }
 
+func parseSecretsId(id string) (project string, config string, err error) {
+	tokens := strings.Split(id, ".")
+	if len(tokens) != 2 {
+		return "", "", errors.New("invalid config ID")
+	}
+	return tokens[0], tokens[1], nil
+}
+
 func getSecretId(project string, config string, name string) string {
 	return strings.Join([]string{project, config, name}, ".")
 }
@@ -129,11 +144,43 @@ type IntegrationResponse struct {
 type SyncData = map[string]interface{}
 
 type Sync struct {
-	Slug        string                 `json:"slug"`
-	Project     string                 `json:"project"`
-	Config      string                 `json:"config"`
-	Integration string                 `json:"integration"`
-	Data        map[string]interface{} `json:"data"`
+	Slug         string                 `json:"slug"`
+	Project      string                 `json:"project"`
+	Config       string                 `json:"config"`
+	Integration  string                 `json:"integration"`
+	Data         map[string]interface{} `json:"data"`
+	Enabled      bool                   `json:"enabled"`
+	LastSyncedAt *string                `json:"lastSyncedAt"`
+}
+
+// Status summarizes the sync's state as `disabled`, `pending` (no completed sync yet), or `synced`.
+func (s Sync) Status() string {
+	if !s.Enabled {
+		return "disabled"
+	}
+	if s.LastSyncedAt == nil || *s.LastSyncedAt == "" {
+		return "pending"
+	}
+	return "synced"
+}
+
+func (s Sync) getResourceId() string {
+	return strings.Join([]string{s.Project, s.Config, s.Slug}, ".")
+}
+
+// parseSyncResourceId parses a sync ID of the form `project.config.slug`.
+// Syncs created by earlier versions of the provider use the bare slug as their ID,
+// in which case the project and config are returned empty.
+func parseSyncResourceId(id string) (project string, config string, slug string, err error) {
+	tokens := strings.Split(id, ".")
+	switch len(tokens) {
+	case 1:
+		return "", "", tokens[0], nil
+	case 3:
+		return tokens[0], tokens[1], tokens[2], nil
+	default:
+		return "", "", "", errors.New("invalid sync ID")
+	}
 }
 
 type SyncResponse struct {
@@ -150,6 +197,25 @@ type RotatedSecret struct {
 	Integration       Integration `json:"integration"`
 	RotationPeriodSec int         `json:"rotation_period_sec"`
 	Name              string      `json:"name"`
+	Status            string      `json:"status"`
+	LastRotatedAt     *string     `json:"last_rotated_at"`
+	NextRotationAt    *string     `json:"next_rotation_at"`
+}
+
+// nextRotationAt returns when the secret is next due to be rotated, falling back to the last rotation plus the
+// rotation period when the API doesn't provide it. Returns an empty string if the secret hasn't been rotated yet.
+func (s RotatedSecret) nextRotationAt() string {
+	if s.NextRotationAt != nil {
+		return *s.NextRotationAt
+	}
+	if s.LastRotatedAt == nil {
+		return ""
+	}
+	lastRotatedAt, err := time.Parse(time.RFC3339, *s.LastRotatedAt)
+	if err != nil {
+		return ""
+	}
+	return lastRotatedAt.Add(time.Duration(s.RotationPeriodSec) * time.Second).UTC().Format(time.RFC3339)
 }
 
 type RotatedSecretResponse struct {
@@ -228,6 +294,10 @@ type ConfigResponse struct {
 	Config Config `json:"config"`
 }
 
+type ConfigsResponse struct {
+	Configs []Config `json:"configs"`
+}
+
 func (c Config) getResourceId() string {
 	return strings.Join([]string{c.Project, c.Environment, c.Name}, ".")
 }
@@ -241,14 +311,15 @@ func parseConfigResourceId(id string) (project string, environment string, name
 }
 
 type ServiceToken struct {
-	Slug        string `json:"slug"`
-	Name        string `json:"name"`
-	Project     string `json:"project"`
-	Environment string `json:"environment"`
-	Config      string `json:"config"`
-	Access      string `json:"access"`
-	Key         string `json:"key"`
-	CreatedAt   string `json:"created_at"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Project     string  `json:"project"`
+	Environment string  `json:"environment"`
+	Config      string  `json:"config"`
+	Access      string  `json:"access"`
+	Key         string  `json:"key"`
+	CreatedAt   string  `json:"created_at"`
+	ExpiresAt   *string `json:"expires_at"`
 }
 
 type ServiceTokenResponse struct {
@@ -272,10 +343,11 @@ func parseServiceTokenResourceId(id string) (project string, config string, slug
 }
 
 type ServiceAccountToken struct {
-	Name      string `json:"name"`
-	ExpiresAt string `json:"expires_at"`
-	CreatedAt string `json:"created_at"`
-	Slug      string `json:"slug"`
+	Name       string `json:"name"`
+	ExpiresAt  string `json:"expires_at"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	Slug       string `json:"slug"`
 }
 
 type ServiceAccountTokenResponse struct {
@@ -283,6 +355,10 @@ type ServiceAccountTokenResponse struct {
 	ApiKey              string              `json:"api_key"`
 }
 
+type ServiceAccountTokensResponse struct {
+	ServiceAccountTokens []ServiceAccountToken `json:"api_tokens"`
+}
+
 func (t ServiceAccountToken) getResourceId() string {
 	return t.Slug
 }
@@ -294,12 +370,14 @@ type ServiceAccountIdentityConfigOidc struct {
 }
 
 type ServiceAccountIdentity struct {
-	Slug       string          `json:"slug"`
-	Name       string          `json:"name"`
-	TtlSeconds int             `json:"ttl_seconds"`
-	Method     string          `json:"method"`
-	Config     json.RawMessage `json:"config"`
-	ConfigOidc ServiceAccountIdentityConfigOidc
+	Slug                string          `json:"slug"`
+	Name                string          `json:"name"`
+	TtlSeconds          int             `json:"ttl_seconds"`
+	Method              string          `json:"method"`
+	Config              json.RawMessage `json:"config"`
+	CreatedAt           string          `json:"created_at"`
+	LastAuthenticatedAt *string         `json:"last_authenticated_at"`
+	ConfigOidc          ServiceAccountIdentityConfigOidc
 }
 
 type ServiceAccountIdentityResponse struct {
@@ -317,7 +395,7 @@ func (response *ServiceAccountIdentityResponse) unmarshal(data []byte) error {
 			return err
 		}
 	default:
-		return errors.New("Unknown auth method type")
+		return fmt.Errorf("Unknown auth method type %q; only \"oidc\" identities are supported by this provider", response.Identity.Method)
 	}
 	return nil
 }
@@ -336,7 +414,7 @@ func (id *ServiceAccountIdentity) marshal() ([]byte, error) {
 			"claims":        id.ConfigOidc.Claims,
 		}
 	default:
-		return nil, errors.New("Unknown auth method type")
+		return nil, fmt.Errorf("Unknown auth method type %q", id.Method)
 	}
 	return json.Marshal(payload)
 }
@@ -361,6 +439,10 @@ type ServiceAccountResponse struct {
 	ServiceAccount ServiceAccount `json:"service_account"`
 }
 
+type ServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccount `json:"service_accounts"`
+}
+
 type SimpleProjectRole struct {
 	Identifier string `json:"identifier"`
 }
@@ -381,6 +463,10 @@ type GetProjectRoleResponse struct {
 	Role ProjectRole `json:"role"`
 }
 
+type ProjectRolesResponse struct {
+	Roles []ProjectRole `json:"roles"`
+}
+
 type CreateProjectRoleResponse struct {
 	Role ProjectRole `json:"role"`
 }
@@ -419,7 +505,20 @@ type GetGroupMembersResponse struct {
 }
 
 type WorkplaceUser struct {
-	Slug string `json:"id"`
+	Slug      string            `json:"id"`
+	Access    string            `json:"access"`
+	CreatedAt string            `json:"created_at"`
+	User      WorkplaceUserInfo `json:"user"`
+}
+
+type WorkplaceUserInfo struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+type WorkplaceUserResponse struct {
+	WorkplaceUser WorkplaceUser `json:"workplace_user"`
 }
 
 type WorkplaceUsersListResponse struct {
@@ -479,6 +578,10 @@ type GetWorkplaceRoleResponse struct {
 	Role WorkplaceRole `json:"role"`
 }
 
+type WorkplaceRolesResponse struct {
+	Roles []WorkplaceRole `json:"roles"`
+}
+
 type CreateWorkplaceRoleResponse struct {
 	Role WorkplaceRole `json:"role"`
 }
@@ -486,3 +589,91 @@ type CreateWorkplaceRoleResponse struct {
 type UpdateWorkplaceRoleResponse struct {
 	Role WorkplaceRole `json:"role"`
 }
+
+type ActivityLog struct {
+	ID          string            `json:"id"`
+	Text        string            `json:"text"`
+	CreatedAt   string            `json:"created_at"`
+	Project     string            `json:"enclave_project"`
+	Environment string            `json:"enclave_environment"`
+	Config      string            `json:"enclave_config"`
+	User        WorkplaceUserInfo `json:"user"`
+}
+
+type ActivityLogsResponse struct {
+	Logs []ActivityLog `json:"logs"`
+}
+
+type ConfigLog struct {
+	ID        string            `json:"id"`
+	Text      string            `json:"text"`
+	CreatedAt string            `json:"created_at"`
+	Project   string            `json:"project"`
+	Config    string            `json:"config"`
+	Rollback  bool              `json:"rollback"`
+	User      WorkplaceUserInfo `json:"user"`
+	// Only the names of changed secrets are parsed, the diff also includes their values
+	Diff []ConfigLogDiff `json:"diff"`
+}
+
+type ConfigLogDiff struct {
+	Name string `json:"name"`
+}
+
+// secretNames returns the names of the secrets changed by the log
+func (l ConfigLog) secretNames() []string {
+	names := []string{}
+	for _, diff := range l.Diff {
+		names = append(names, diff.Name)
+	}
+	return names
+}
+
+type ConfigLogResponse struct {
+	Log ConfigLog `json:"log"`
+}
+
+type ConfigLogsResponse struct {
+	Logs []ConfigLog `json:"logs"`
+}
+
+type MeWorkplace struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type Me struct {
+	Slug         string      `json:"slug"`
+	Name         string      `json:"name"`
+	Type         string      `json:"type"`
+	TokenPreview string      `json:"token_preview"`
+	CreatedAt    string      `json:"created_at"`
+	LastSeenAt   string      `json:"last_seen_at"`
+	Workplace    MeWorkplace `json:"workplace"`
+}
+
+type OIDCAuthResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type TrustedIPsResponse struct {
+	IPs []string `json:"ips"`
+}
+
+type Workplace struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	BillingEmail  string `json:"billing_email"`
+	SecurityEmail string `json:"security_email"`
+}
+
+type WorkplaceResponse struct {
+	Workplace Workplace `json:"workplace"`
+}
+
+type ShareLink struct {
+	URL              string `json:"url"`
+	AuthenticatedURL string `json:"authenticated_url"`
+	Password         string `json:"password"`
+}
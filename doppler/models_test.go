@@ -0,0 +1,26 @@
+package doppler
+
+import "testing"
+
+func TestSyncStatus(t *testing.T) {
+	lastSyncedAt := "2024-01-01T00:00:00Z"
+	empty := ""
+	tests := []struct {
+		name     string
+		sync     Sync
+		expected string
+	}{
+		{name: "never synced", sync: Sync{Enabled: true}, expected: "pending"},
+		{name: "empty last synced at", sync: Sync{Enabled: true, LastSyncedAt: &empty}, expected: "pending"},
+		{name: "synced", sync: Sync{Enabled: true, LastSyncedAt: &lastSyncedAt}, expected: "synced"},
+		{name: "disabled after syncing", sync: Sync{Enabled: false, LastSyncedAt: &lastSyncedAt}, expected: "disabled"},
+		{name: "disabled before syncing", sync: Sync{Enabled: false}, expected: "disabled"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if status := test.sync.Status(); status != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, status)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package doppler
+
+import (
+	"sync"
+)
+
+// mutexKV is a set of mutexes keyed by string, used to serialize operations on the same Doppler object.
+type mutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{store: map[string]*sync.Mutex{}}
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+// Lock locks the mutex for the given key, creating it if necessary.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex for the given key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+// configSecretsMutex serializes secret writes to the same config so that parallel resources in an apply
+// can't drop each other's changes.
+var configSecretsMutex = newMutexKV()
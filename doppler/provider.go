@@ -2,21 +2,30 @@ package doppler
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const defaultAPIHost = "https://api.doppler.com"
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"host": {
-				Description: "The Doppler API host (i.e. https://api.doppler.com). This can also be set via the DOPPLER_API_HOST environment variable.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_API_HOST", defaultAPIHost),
+				Description:  "The Doppler API host (i.e. https://api.doppler.com). The host may include a base path when the API is served behind a gateway. This can also be set via the DOPPLER_API_HOST environment variable.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_API_HOST", defaultAPIHost),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"verify_tls": {
 				Description: "Whether or not to verify TLS. This can also be set via the DOPPLER_VERIFY_TLS environment variable.",
@@ -24,23 +33,118 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_VERIFY_TLS", true),
 			},
+			"ca_cert_file": {
+				Description:   "Path to a PEM-encoded CA certificate bundle to trust in addition to the system's root certificates. This can also be set via the DOPPLER_CA_CERT_FILE environment variable.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("DOPPLER_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Description:   "A PEM-encoded CA certificate bundle to trust in addition to the system's root certificates. This can also be set via the DOPPLER_CA_CERT_PEM environment variable.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("DOPPLER_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"proxy_url": {
+				Description:  "The URL of an HTTP, HTTPS, or SOCKS5 proxy to send API requests through. If not set, the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables are respected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"max_retries": {
+				Description:  "The maximum number of attempts made for a request that fails with a transient error, such as rate limiting or a timeout. Idempotent requests (e.g. reads and deletes) are also retried on 502, 503, and 504 responses and dropped connections. Defaults to `10`. This can also be set via the DOPPLER_MAX_RETRIES environment variable.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_MAX_RETRIES", MAX_RETRIES),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_base_delay_ms": {
+				Description:  "The delay, in milliseconds, before the first retry of a failed request. The delay doubles (with jitter) on each subsequent retry. Defaults to `500`. This can also be set via the DOPPLER_RETRY_BASE_DELAY_MS environment variable.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_RETRY_BASE_DELAY_MS", 500),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"on_resource_not_found": {
+				Description:  "How to handle a managed resource that no longer exists in Doppler during refresh. Either `remove` (default), which removes it from state with a warning so it is recreated, or `error`, which fails the refresh so the disappearance can be investigated.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "remove",
+				ValidateFunc: validation.StringInSlice([]string{"remove", "error"}, false),
+			},
+			"page_size": {
+				Description:  "The number of items requested per page when the provider lists every item of a paginated API, such as group members or service accounts. Defaults to `100`. This can also be set via the DOPPLER_PAGE_SIZE environment variable.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_PAGE_SIZE", DEFAULT_PAGE_SIZE),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_concurrent_requests": {
+				Description:  "The maximum number of API requests the provider sends concurrently. Use this to stay under Doppler's rate limits without lowering Terraform's parallelism. Defaults to `0` (unlimited). This can also be set via the DOPPLER_MAX_CONCURRENT_REQUESTS environment variable.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"request_timeout_seconds": {
+				Description:  "The longest, in seconds, a single API request may take before it fails and is retried. Set to `0` to only bound requests by the resource's `timeouts`. Defaults to `30`. This can also be set via the DOPPLER_REQUEST_TIMEOUT_SECONDS environment variable.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DOPPLER_REQUEST_TIMEOUT_SECONDS", int(DEFAULT_REQUEST_TIMEOUT/time.Second)),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"debug_http": {
+				Description: "Whether to log the headers and bodies of Doppler API requests and responses at the DEBUG log level (e.g. `TF_LOG_PROVIDER=DEBUG`). Secret values, tokens, credentials, and the Authorization header are redacted. This can also be set via the DOPPLER_DEBUG_HTTP environment variable.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_DEBUG_HTTP", false),
+			},
 			"doppler_token": {
-				Description: "A Doppler token, either a personal or service token. This can also be set via the DOPPLER_TOKEN environment variable.",
+				Description: "A Doppler token, either a personal or service token. This can also be set via the DOPPLER_TOKEN environment variable. If no token is provided, the token used by the Doppler CLI in the current directory is used, unless the CLI stores it in the OS keychain.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_TOKEN", nil),
 			},
+			"oidc_identity_id": {
+				Description: "The ID of a service account identity to authenticate as. When set, the OIDC token is exchanged for a short-lived Doppler token and `doppler_token` is ignored. This can also be set via the DOPPLER_OIDC_IDENTITY_ID environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DOPPLER_OIDC_IDENTITY_ID", nil),
+			},
+			"oidc_token": {
+				Description:   "An OIDC token (JWT) issued by your CI provider to exchange for a Doppler token. This can also be set via the DOPPLER_OIDC_TOKEN environment variable.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("DOPPLER_OIDC_TOKEN", nil),
+				ConflictsWith: []string{"oidc_token_file"},
+			},
+			"oidc_token_file": {
+				Description:   "Path to a file containing an OIDC token (JWT) to exchange for a Doppler token. This can also be set via the DOPPLER_OIDC_TOKEN_FILE environment variable.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("DOPPLER_OIDC_TOKEN_FILE", nil),
+				ConflictsWith: []string{"oidc_token"},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"doppler_secret":        resourceSecret(),
-			"doppler_project":       resourceProject(),
-			"doppler_environment":   resourceEnvironment(),
-			"doppler_config":        resourceConfig(),
-			"doppler_service_token": resourceServiceToken(),
+			"doppler_secret":          resourceSecret(),
+			"doppler_project":         resourceProject(),
+			"doppler_environment":     resourceEnvironment(),
+			"doppler_config":          resourceConfig(),
+			"doppler_config_lock":     resourceConfigLock(),
+			"doppler_config_rollback": resourceConfigRollback(),
+			"doppler_service_token":   resourceServiceToken(),
+			"doppler_trusted_ips":     resourceTrustedIPs(),
+			"doppler_share_link":      resourceShareLink(),
 
 			"doppler_project_role": resourceProjectRole(),
 
-			"doppler_workplace_role": resourceWorkplaceRole(),
+			"doppler_workplace_role":     resourceWorkplaceRole(),
+			"doppler_workplace_settings": resourceWorkplaceSettings(),
+			"doppler_workplace_user":     resourceWorkplaceUser(),
 
 			"doppler_service_account":          resourceServiceAccount(),
 			"doppler_service_account_token":    resourceServiceAccountToken(),
@@ -79,6 +183,10 @@ func Provider() *schema.Provider {
 			"doppler_integration_flyio":  resourceIntegrationFlyio(),
 			"doppler_secrets_sync_flyio": resourceSyncFlyio(),
 
+			// creating DigitalOcean oauth integrations is not currently supported
+			// "doppler_integration_digitalocean":  resourceIntegrationDigitalOcean(),
+			"doppler_secrets_sync_digitalocean_app": resourceSyncDigitalOceanApp(),
+
 			"doppler_integration_twilio":                   resourceIntegrationTwilio(),
 			"doppler_integration_cloudflare_tokens":        resourceIntegrationCloudflareTokens(),
 			"doppler_integration_mongodb_atlas":            resourceIntegrationMongoDBAtlas(),
@@ -122,21 +230,139 @@ func Provider() *schema.Provider {
 			"doppler_secrets_sync_supabase": resourceSyncSupabase(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"doppler_secrets":      dataSourceSecrets(),
-			"doppler_user":         dataSourceUser(),
-			"doppler_group":        dataSourceGroup(),
-			"doppler_environments": dataSourceEnvironments(),
+			"doppler_secret":                   dataSourceSecret(),
+			"doppler_secrets":                  dataSourceSecrets(),
+			"doppler_secrets_download":         dataSourceSecretsDownload(),
+			"doppler_rotated_secret":           dataSourceRotatedSecret(),
+			"doppler_user":                     dataSourceUser(),
+			"doppler_group":                    dataSourceGroup(),
+			"doppler_environments":             dataSourceEnvironments(),
+			"doppler_config":                   dataSourceConfig(),
+			"doppler_configs":                  dataSourceConfigs(),
+			"doppler_service_accounts":         dataSourceServiceAccounts(),
+			"doppler_service_account_tokens":   dataSourceServiceAccountTokens(),
+			"doppler_service_account_identity": dataSourceServiceAccountIdentity(),
+			"doppler_identity_token":           dataSourceIdentityToken(),
+			"doppler_activity_logs":            dataSourceActivityLogs(),
+			"doppler_config_logs":              dataSourceConfigLogs(),
+			"doppler_me":                       dataSourceMe(),
+			"doppler_workplace":                dataSourceWorkplace(),
+			"doppler_project_roles":            dataSourceProjectRoles(),
+			"doppler_workplace_roles":          dataSourceWorkplaceRoles(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		addDefaultTimeouts(resource)
+		addDeprecationWarnings(name, resource)
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		addDeprecationWarnings(name, dataSource)
+	}
+
+	return provider
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	host := d.Get("host").(string)
+	// Paths are appended directly to the host, so avoid sending requests to `//v3/...`
+	host := strings.TrimRight(d.Get("host").(string), "/")
 	verifyTLS := d.Get("verify_tls").(bool)
 	token := d.Get("doppler_token").(string)
+	maxRetries := d.Get("max_retries").(int)
+	retryBaseDelay := time.Duration(d.Get("retry_base_delay_ms").(int)) * time.Millisecond
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	pageSize := d.Get("page_size").(int)
+	requestTimeout := time.Duration(d.Get("request_timeout_seconds").(int)) * time.Second
 
 	var diags diag.Diagnostics
 
-	return APIClient{Host: host, APIKey: token, VerifyTLS: verifyTLS}, diags
+	rootCAs, err := loadRootCAs(d.Get("ca_cert_file").(string), d.Get("ca_cert_pem").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	var proxyURL *url.URL
+	if rawProxyURL := d.Get("proxy_url").(string); rawProxyURL != "" {
+		proxyURL, err = url.Parse(rawProxyURL)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	client := APIClient{Host: host, APIKey: token, VerifyTLS: verifyTLS, RootCAs: rootCAs, ProxyURL: proxyURL, MaxRetries: maxRetries, RetryBaseDelay: retryBaseDelay, PageSize: pageSize, RequestTimeout: requestTimeout, NotFoundBehavior: d.Get("on_resource_not_found").(string), DebugHTTP: d.Get("debug_http").(bool)}
+	if maxConcurrentRequests > 0 {
+		client.requestSlots = make(chan struct{}, maxConcurrentRequests)
+	}
+	client.responseCache = newResponseCache()
+	client.deprecationWarnings = newDeprecationWarnings()
+
+	if identity := d.Get("oidc_identity_id").(string); identity != "" {
+		oidcToken := d.Get("oidc_token").(string)
+		if oidcTokenFile := d.Get("oidc_token_file").(string); oidcTokenFile != "" {
+			contents, err := os.ReadFile(oidcTokenFile)
+			if err != nil {
+				return nil, diag.Errorf("Unable to read OIDC token file %s: %s", oidcTokenFile, err)
+			}
+			oidcToken = strings.TrimSpace(string(contents))
+		}
+		if oidcToken == "" {
+			return nil, diag.Errorf("oidc_token or oidc_token_file must be set when using oidc_identity_id")
+		}
+
+		auth, err := client.ExchangeOIDCToken(ctx, identity, oidcToken)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		client.APIKey = auth.Token
+	}
+
+	if client.APIKey == "" {
+		cliToken, err := getCLIToken()
+		if errors.Is(err, errCLITokenInKeychain) {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read Doppler CLI token",
+				Detail:   "The Doppler CLI token is stored in the OS keychain, which the provider can't read. Set doppler_token (or DOPPLER_TOKEN) instead.",
+			})
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to read Doppler CLI configuration",
+				Detail:   err.Error(),
+			})
+		}
+		client.APIKey = cliToken
+	}
+
+	if client.APIKey == "" {
+		return nil, append(diags, diag.Errorf("A Doppler token must be provided using doppler_token (or DOPPLER_TOKEN), an OIDC identity using oidc_identity_id, or by logging in with the Doppler CLI")...)
+	}
+
+	return client, diags
+}
+
+// loadRootCAs returns the system's root certificates along with any additional PEM-encoded certificates.
+// If no additional certificates are provided, nil is returned so that the default roots are used.
+func loadRootCAs(certFile string, certPEM string) (*x509.CertPool, error) {
+	if certFile != "" {
+		contents, err := os.ReadFile(certFile)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read CA certificate file %s: %w", certFile, err)
+		}
+		certPEM = string(contents)
+	}
+	if certPEM == "" {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(certPEM)) {
+		return nil, errors.New("No valid PEM-encoded certificates were found in the CA certificate bundle")
+	}
+	return pool, nil
 }
@@ -0,0 +1,215 @@
+package doppler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProvider(t *testing.T) {
+	if err := doppler.Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderOIDCDoesNotSendDopplerToken(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	server.AddOIDCIdentity("identity_1", "oidc.jwt")
+
+	// A static token (e.g. from DOPPLER_TOKEN) must not be sent along with the OIDC token
+	p := newTestProvider(t, server, map[string]interface{}{
+		"doppler_token":    "dp.st.static",
+		"oidc_identity_id": "identity_1",
+		"oidc_token":       "oidc.jwt",
+	})
+	if header := server.RequestHeader(http.MethodPost, "/v3/auth/oidc"); header == nil || header.Get("Authorization") != "" {
+		t.Errorf("Expected the OIDC exchange to be sent without an Authorization header, got %v", header)
+	}
+	if apiKey := testClient(p).APIKey; apiKey != dopplertest.Token {
+		t.Errorf("Expected the exchanged token to be used, got %q", apiKey)
+	}
+}
+
+func TestProviderValidatesBounds(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value int
+		valid bool
+	}{
+		{name: "max_retries", key: "max_retries", value: 1, valid: true},
+		{name: "zero max_retries", key: "max_retries", value: 0, valid: false},
+		{name: "retry_base_delay_ms", key: "retry_base_delay_ms", value: 0, valid: true},
+		{name: "negative retry_base_delay_ms", key: "retry_base_delay_ms", value: -1, valid: false},
+		{name: "page_size", key: "page_size", value: 1, valid: true},
+		{name: "zero page_size", key: "page_size", value: 0, valid: false},
+		{name: "unlimited max_concurrent_requests", key: "max_concurrent_requests", value: 0, valid: true},
+		{name: "negative max_concurrent_requests", key: "max_concurrent_requests", value: -1, valid: false},
+		{name: "no request_timeout_seconds", key: "request_timeout_seconds", value: 0, valid: true},
+		{name: "negative request_timeout_seconds", key: "request_timeout_seconds", value: -1, valid: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{test.key: test.value})
+			diags := doppler.Provider().Validate(config)
+			if diags.HasError() == test.valid {
+				t.Errorf("Expected valid=%t for %s = %d, got %v", test.valid, test.key, test.value, diags)
+			}
+		})
+	}
+}
+
+func TestProviderReportsCLITokenInKeychain(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("DOPPLER_TOKEN", "")
+	if err := os.MkdirAll(filepath.Join(home, ".doppler"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".doppler", ".doppler.yaml"), []byte("scoped:\n    /:\n        token: keychain-reference\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	diags := doppler.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if !diags.HasError() {
+		t.Fatal("Expected the provider configuration to fail")
+	}
+	if detail := diags[len(diags)-1].Detail; !strings.Contains(detail, "OS keychain") || !strings.Contains(detail, "DOPPLER_TOKEN") {
+		t.Errorf("Expected the keychain to be named as the cause, got %v", diags)
+	}
+}
+
+// newTestProvider returns the provider configured against the fake API. Retries aren't delayed.
+func newTestProvider(t *testing.T, server *dopplertest.Server, extra map[string]interface{}) *schema.Provider {
+	t.Helper()
+	raw := map[string]interface{}{
+		"host":                server.URL,
+		"doppler_token":       dopplertest.Token,
+		"retry_base_delay_ms": 0,
+	}
+	for key, value := range extra {
+		raw[key] = value
+	}
+	p := doppler.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	return p
+}
+
+func testClient(p *schema.Provider) doppler.APIClient {
+	return p.Meta().(doppler.APIClient)
+}
+
+// createTestConfig creates a project with a single config in the fake API
+func createTestConfig(t *testing.T, p *schema.Provider, project string, config string) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := testClient(p).CreateProject(ctx, project, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := testClient(p).CreateConfig(ctx, project, config, config); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testResourceConfig builds the configuration Terraform would send for the resource, including the
+// raw (cty) value so that `GetRawConfig` behaves as it does outside of tests.
+func testResourceConfig(t *testing.T, r *schema.Resource, raw map[string]interface{}) *terraform.ResourceConfig {
+	t.Helper()
+	block := r.CoreConfigSchema()
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := ctyjson.Unmarshal(encoded, block.ImpliedType())
+	if err != nil {
+		t.Fatalf("Invalid test configuration: %s", err)
+	}
+	config := terraform.NewResourceConfigShimmed(value, block)
+	config.CtyValue = value
+	return config
+}
+
+// planResource diffs the configuration against the state, as `terraform plan` would
+func planResource(t *testing.T, p *schema.Provider, name string, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceDiff {
+	t.Helper()
+	r := p.ResourcesMap[name]
+	config := testResourceConfig(t, r, raw)
+	diff, err := r.Diff(context.Background(), state, config, p.Meta())
+	if err != nil {
+		t.Fatalf("Unable to plan %s: %s", name, err)
+	}
+	if diff != nil {
+		diff.RawConfig = config.CtyValue
+	}
+	return diff
+}
+
+// applyResource plans and applies the configuration, returning the new state
+func applyResource(t *testing.T, p *schema.Provider, name string, state *terraform.InstanceState, raw map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	diff := planResource(t, p, name, state, raw)
+	if diff == nil {
+		return state, nil
+	}
+	return p.ResourcesMap[name].Apply(context.Background(), state, diff, p.Meta())
+}
+
+// destroyResource deletes the resource in the state
+func destroyResource(t *testing.T, p *schema.Provider, name string, state *terraform.InstanceState) diag.Diagnostics {
+	t.Helper()
+	_, diags := p.ResourcesMap[name].Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, p.Meta())
+	return diags
+}
+
+// refreshResource reads the resource's current state from the API
+func refreshResource(t *testing.T, p *schema.Provider, name string, state *terraform.InstanceState) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	return p.ResourcesMap[name].RefreshWithoutUpgrade(context.Background(), state, p.Meta())
+}
+
+// readDataSource reads the data source with the configuration
+func readDataSource(t *testing.T, p *schema.Provider, name string, raw map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	r := p.DataSourcesMap[name]
+	config := testResourceConfig(t, r, raw)
+	diff, err := r.Diff(context.Background(), nil, config, p.Meta())
+	if err != nil {
+		t.Fatalf("Unable to plan %s: %s", name, err)
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{}
+	}
+	diff.RawConfig = config.CtyValue
+	return r.ReadDataApply(context.Background(), diff, p.Meta())
+}
+
+// importResource imports the resource by ID and refreshes it, as `terraform import` would
+func importResource(t *testing.T, p *schema.Provider, name string, id string) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	r := p.ResourcesMap[name]
+	d := r.Data(nil)
+	d.SetId(id)
+	imported, err := r.Importer.StateContext(context.Background(), d, p.Meta())
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("Expected a single imported resource, got %d", len(imported))
+	}
+	return refreshResource(t, p, name, imported[0].State())
+}
@@ -1,7 +1,11 @@
 package doppler
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,15 +19,36 @@ func (e *CustomNotFoundError) Error() string {
 	return fmt.Sprintf("Doppler Error: %s", e.Message)
 }
 
-func handleNotFoundError(err error, d *schema.ResourceData) diag.Diagnostics {
-	isNotFoundError := false
-
+func isNotFoundError(err error) bool {
 	if apiError, ok := err.(*APIError); ok && apiError.Response != nil && apiError.Response.HTTPResponse.StatusCode == 404 {
-		isNotFoundError = true
+		return true
 	}
 
 	if _, ok := err.(*CustomNotFoundError); ok {
-		isNotFoundError = true
+		return true
+	}
+
+	return false
+}
+
+func isConflictError(err error) bool {
+	apiError, ok := err.(*APIError)
+	return ok && apiError.Response != nil && apiError.Response.HTTPResponse != nil && apiError.Response.HTTPResponse.StatusCode == http.StatusConflict
+}
+
+func handleNotFoundError(err error, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	isNotFoundError := isNotFoundError(err)
+	// Terraform adds the resource address to the diagnostic, but only the provider knows which Doppler object is missing
+	id := d.Id()
+
+	if client, ok := m.(APIClient); isNotFoundError && ok && client.NotFoundBehavior == "error" {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Error(),
+				Detail:   fmt.Sprintf("The Doppler object with ID %q was not found. It has been left in state because the provider's `on_resource_not_found` is set to `error`. Restore the resource in Doppler, or remove it from state with `terraform state rm` if its deletion was intended.", id),
+			},
+		}
 	}
 
 	if isNotFoundError {
@@ -34,10 +59,121 @@ func handleNotFoundError(err error, d *schema.ResourceData) diag.Diagnostics {
 			diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  err.Error(),
-				Detail:   "Resource was not found, so it was removed from state and is being recreated.",
+				Detail:   fmt.Sprintf("The Doppler object with ID %q was not found, so it was removed from state and is being recreated. It was likely deleted outside of Terraform.", id),
 			},
 		}
 	}
 
 	return diag.FromErr(err)
 }
+
+// importStateCompositeId returns an importer for resources whose IDs are composed of `count` dot-separated parts.
+// Import IDs may use "/" or "." as the separator.
+func importStateCompositeId(count int) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts, ok := splitImportId(d.Id(), count)
+		if !ok {
+			return nil, fmt.Errorf("Unexpected import ID format %q, expected %d parts separated by \"/\"", d.Id(), count)
+		}
+		d.SetId(strings.Join(parts, "."))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// splitImportId splits a composite import ID into exactly `count` parts.
+// Parts may be separated by "/" or, for compatibility with older import IDs, ".".
+func splitImportId(id string, count int) ([]string, bool) {
+	separator := "."
+	if strings.Contains(id, "/") {
+		separator = "/"
+	}
+	parts := strings.Split(id, separator)
+	if len(parts) != count {
+		return nil, false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, false
+		}
+	}
+	return parts, true
+}
+
+// tokenOverrideSchema returns the schema for a `token` argument which overrides the provider's token for a single resource or data source.
+func tokenOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A Doppler token to use for this resource instead of the provider's token (e.g. a service token scoped to the config)",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	}
+}
+
+// getAPIClient returns the provider's API client, authenticated with the resource's `token` override if one is set.
+func getAPIClient(d *schema.ResourceData, m interface{}) APIClient {
+	client := m.(APIClient)
+	if token, ok := d.GetOk("token"); ok {
+		client.APIKey = token.(string)
+	}
+	return client
+}
+
+// The timeout applied to resource operations unless overridden in a `timeouts` block
+const defaultResourceTimeout = 20 * time.Minute
+
+// addDefaultTimeouts allows a `timeouts` block to be configured for each of the resource's operations.
+// Timeouts that the resource already defines are left unchanged.
+func addDefaultTimeouts(resource *schema.Resource) {
+	if resource.Timeouts == nil {
+		resource.Timeouts = &schema.ResourceTimeout{}
+	}
+	timeouts := resource.Timeouts
+	if timeouts.Create == nil && (resource.CreateContext != nil || resource.Create != nil) {
+		timeouts.Create = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if timeouts.Read == nil {
+		timeouts.Read = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if timeouts.Update == nil && (resource.UpdateContext != nil || resource.Update != nil) {
+		timeouts.Update = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if timeouts.Delete == nil && (resource.DeleteContext != nil || resource.Delete != nil) {
+		timeouts.Delete = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+}
+
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Whether Terraform is prevented from destroying the resource. While enabled, any plan that destroys or replaces the resource fails during apply; set it to `false` in a prior apply before destroying. Defaults to `false`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+}
+
+// checkDeletionProtection returns an error if the resource's `deletion_protection` is enabled
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) diag.Diagnostics {
+	if !d.Get("deletion_protection").(bool) {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot destroy %s %s with deletion_protection enabled", resourceType, d.Id()),
+			Detail:   "Set `deletion_protection` to `false` and apply the change before destroying or replacing this resource.",
+		},
+	}
+}
+
+// suppressEquivalentTimes suppresses diffs between RFC 3339 times which refer to the same instant
+// (e.g. the API returning `2024-01-01T00:00:00.000Z` for `2024-01-01T00:00:00Z`)
+func suppressEquivalentTimes(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -6,14 +6,16 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var resourceChangeRequestPolicyRequiredReviewers = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"count": {
-			Description: "The number of approvals a change request must receive before it can be applied",
-			Type:        schema.TypeInt,
-			Required:    true,
+			Description:  "The number of approvals a change request must receive before it can be applied",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"user_slugs": {
 			Description: "If set, only approvals from these users will satisfy this rule",
@@ -43,10 +45,11 @@ var resourceChangeRequestPolicyRules = schema.Resource{
 			Default:     false,
 		},
 		"auto_assign_reviewers": {
-			Description: "If set, the strategy used to automatically assign reviewers to CRs targeted by this policy. Valid values: all, matchCount, never (default)",
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "never",
+			Description:  "If set, the strategy used to automatically assign reviewers to CRs targeted by this policy. Valid values: all, matchCount, never (default)",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "never",
+			ValidateFunc: validation.StringInSlice([]string{"all", "matchCount", "never"}, false),
 		},
 		"required_reviewers": {
 			Description: "Enforces that a specific number of users approve a change request before it can be applied",
@@ -200,7 +203,7 @@ func resourceChangeRequestPolicyRead(ctx context.Context, d *schema.ResourceData
 
 	policy, err := client.GetChangeRequestPolicy(ctx, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	diags = updateChangeRequestPolicyState(d, policy, diags)
@@ -15,10 +15,11 @@ func resourceConfig() *schema.Resource {
 		CreateContext: resourceConfigCreate,
 		ReadContext:   resourceConfigRead,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateCompositeId(3),
 		},
 		UpdateContext: resourceConfigUpdate,
 		DeleteContext: resourceConfigDelete,
+		CustomizeDiff: customizeDiffConfigName,
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Description: "The name of the Doppler project where the config is located",
@@ -35,9 +36,10 @@ func resourceConfig() *schema.Resource {
 				ForceNew: true,
 			},
 			"name": {
-				Description: "The name of the Doppler config",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The name of the Doppler config. Names may only contain lowercase letters, numbers, dashes, and underscores, and must be the environment's slug (for the root config) or start with the environment's slug followed by an underscore (for a branch config).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlug("config name"),
 			},
 			"descriptor": {
 				Description: "The descriptor (project.config) of the Doppler config",
@@ -58,6 +60,17 @@ func resourceConfig() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
+			"root": {
+				Description: "Whether the config is the root config of its environment",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"locked": {
+				Description: "Whether the config is locked, preventing it from being renamed or deleted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -106,6 +119,14 @@ func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("root", config.Root); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("locked", config.Locked); err != nil {
+		return diag.FromErr(err)
+	}
+
 	updateInheritable := func() diag.Diagnostics {
 		if config.Inheritable != inheritable {
 			// Configs are always created as not inheritable, and inheritability cannot be specified during the creation request.
@@ -238,7 +259,7 @@ func resourceConfigRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	config, err := client.GetConfig(ctx, project, name)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	if err = d.Set("project", config.Project); err != nil {
@@ -261,6 +282,14 @@ func resourceConfigRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("root", config.Root); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("locked", config.Locked); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var descriptorsStrs []string
 
 	for _, descriptor := range config.Inherits {
@@ -281,6 +310,9 @@ func resourceConfigDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if diags := checkDeletionProtection(d, "config"); diags.HasError() {
+		return diags
+	}
 	if env == name {
 		return diag.Diagnostics{
 			diag.Diagnostic{
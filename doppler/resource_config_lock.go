@@ -0,0 +1,98 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceConfigLock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConfigLockCreate,
+		ReadContext:   resourceConfigLockRead,
+		DeleteContext: resourceConfigLockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateCompositeId(2),
+		},
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project where the config is located",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config to lock",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceConfigLockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+
+	if _, err := client.LockConfig(ctx, project, config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getSecretsId(project, config))
+
+	readDiags := resourceConfigLockRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceConfigLockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project, name, err := parseSecretsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := client.GetConfig(ctx, project, name)
+	if err != nil {
+		return handleNotFoundError(err, d, m)
+	}
+
+	// The config was unlocked outside of Terraform, so the lock needs to be re-created
+	if !config.Locked {
+		d.SetId("")
+		return diags
+	}
+
+	if err = d.Set("project", config.Project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("config", config.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceConfigLockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project, config, err := parseSecretsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err = client.UnlockConfig(ctx, project, config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,114 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceConfigRollback() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConfigRollbackCreate,
+		ReadContext:   resourceConfigRollbackRead,
+		DeleteContext: resourceConfigRollbackDelete,
+		// ForceNew is specified for all user-specified fields
+		// A rollback is a one-time action, so any change performs a new rollback
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config to roll back",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"log_id": {
+				Description: "The ID of the config log (version) to roll back to, e.g. from the `doppler_config_logs` data source",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values which perform the rollback again when changed (e.g. the version of the application being deployed)",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"rollback_log_id": {
+				Description: "The ID of the config log created by the rollback",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "When the rollback was performed",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceConfigRollbackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+	logId := d.Get("log_id").(string)
+
+	configSecretsMutex.Lock(getSecretsId(project, config))
+	log, err := client.RollbackConfigLog(ctx, project, config, logId)
+	configSecretsMutex.Unlock(getSecretsId(project, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getSecretId(project, config, log.ID))
+
+	readDiags := resourceConfigRollbackRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceConfigRollbackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project, config, rollbackLogId, err := parseSecretId(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid config rollback ID: %w", err))
+	}
+
+	log, err := client.GetConfigLog(ctx, project, config, rollbackLogId)
+	if err != nil {
+		return handleNotFoundError(err, d, m)
+	}
+
+	if err = d.Set("rollback_log_id", log.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("created_at", log.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceConfigRollbackDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Rollbacks cannot be undone, the config's later versions remain in its logs
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Config rollbacks cannot be undone",
+			Detail:   "This operation only removes the rollback from the Terraform state. The config's secrets are unchanged; to restore a later version, roll back to that version's log.",
+		},
+	}
+}
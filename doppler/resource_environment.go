@@ -12,7 +12,7 @@ func resourceEnvironment() *schema.Resource {
 		CreateContext: resourceEnvironmentCreate,
 		ReadContext:   resourceEnvironmentRead,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateCompositeId(2),
 		},
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
@@ -25,15 +25,17 @@ func resourceEnvironment() *schema.Resource {
 				ForceNew: true,
 			},
 			"slug": {
-				Description: "The slug of the Doppler environment",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The slug of the Doppler environment. Slugs may only contain lowercase letters, numbers, dashes, and underscores.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlug("environment slug"),
 			},
 			"name": {
 				Description: "The name of the Doppler environment",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"personal_configs": {
 				Description: "Whether or not personal configs are enabled for the environment",
 				Type:        schema.TypeBool,
@@ -95,7 +97,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	environment, err := client.GetEnvironment(ctx, project, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	if err = d.Set("slug", environment.Slug); err != nil {
@@ -126,6 +128,10 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if diags := checkDeletionProtection(d, "environment"); diags.HasError() {
+		return diags
+	}
+
 	if err = client.DeleteEnvironment(ctx, project, slug); err != nil {
 		return diag.FromErr(err)
 	}
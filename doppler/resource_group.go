@@ -131,7 +131,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	group, err := client.GetGroup(ctx, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	err = updateGroupState(d, group)
@@ -17,6 +17,9 @@ type ResourceGroupMemberBuilder struct {
 	// Any additional schema fields for the resource
 	DataSchema map[string]*schema.Schema
 
+	// The schema field which holds the member slug
+	MemberSlugField string
+
 	// A function which uses the resource data to return a member slug
 	GetMemberSlugFunc ResourceGroupMemberGetMemberSlugFunc
 }
@@ -41,7 +44,7 @@ func (builder ResourceGroupMemberBuilder) Build() *schema.Resource {
 		CreateContext: builder.CreateContextFunc(),
 		ReadContext:   builder.ReadContextFunc(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateCompositeId(3),
 		},
 		DeleteContext: builder.DeleteContextFunc(),
 		Schema:        resourceSchema,
@@ -84,7 +87,15 @@ func (builder ResourceGroupMemberBuilder) ReadContextFunc() schema.ReadContextFu
 
 		err = client.GetGroupMember(ctx, group, memberType, memberSlug)
 		if err != nil {
-			return handleNotFoundError(err, d)
+			return handleNotFoundError(err, d, m)
+		}
+
+		if err = d.Set("group_slug", group); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set(builder.MemberSlugField, memberSlug); err != nil {
+			return diag.FromErr(err)
 		}
 
 		return diags
@@ -8,7 +8,8 @@ import (
 
 func resourceGroupMemberWorkplaceUser() *schema.Resource {
 	builder := ResourceGroupMemberBuilder{
-		MemberType: "workplace_user",
+		MemberType:      "workplace_user",
+		MemberSlugField: "user_slug",
 		DataSchema: map[string]*schema.Schema{
 			"user_slug": {
 				Description: "The slug of the Doppler workplace user",
@@ -90,22 +90,9 @@ func resourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, m int
 
 	groupSlug := d.Id()
 
-	perPage := 1000
-	maxPages := 5
-
-	members := []GroupMember{}
-
-	for page := 1; page <= maxPages; page++ {
-		pageMembers, err := client.GetGroupMembers(ctx, groupSlug, PageOptions{Page: page, PerPage: perPage})
-		if err != nil {
-			return handleNotFoundError(err, d)
-		}
-		members = append(members, pageMembers...)
-		if len(pageMembers) < perPage {
-			break
-		} else if page == maxPages {
-			return diag.Errorf("Exceeded max number of group members")
-		}
+	members, err := client.GetAllGroupMembers(ctx, groupSlug)
+	if err != nil {
+		return handleNotFoundError(err, d, m)
 	}
 
 	userSlugs := []string{}
@@ -35,7 +35,10 @@ func (builder ResourceIntegrationBuilder) Build() *schema.Resource {
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: builder.UpdateContextFunc(),
 		DeleteContext: builder.DeleteContextFunc(),
-		Schema:        resourceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
 	}
 }
 
@@ -100,7 +103,7 @@ func (builder ResourceIntegrationBuilder) ReadContextFunc() schema.ReadContextFu
 
 		integ, err := client.GetIntegration(ctx, slug)
 		if err != nil {
-			return handleNotFoundError(err, d)
+			return handleNotFoundError(err, d, m)
 		}
 
 		if err = d.Set("name", integ.Name); err != nil {
@@ -2,9 +2,11 @@ package doppler
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceProject() *schema.Resource {
@@ -18,15 +20,24 @@ func resourceProject() *schema.Resource {
 		DeleteContext: resourceProjectDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "The name of the Doppler project",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The name of the Doppler project. Names may only contain lowercase letters, numbers, dashes, and underscores.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlug("project name"),
 			},
 			"description": {
 				Description: "The description of the Doppler project",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
+			"on_destroy": {
+				Description: "What happens to the project when this resource is destroyed. Either `delete` (default), which deletes the project along with all of its configs and secrets, or `abandon`, which only removes the project from the Terraform state and leaves it in Doppler.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// Implicitly defaults to "delete" but not defined here to avoid state migration
+				ValidateFunc: validation.StringInSlice([]string{"delete", "abandon"}, false),
+			},
 		},
 	}
 }
@@ -73,7 +84,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	project, err := client.GetProject(ctx, name)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	if err = d.Set("name", project.Name); err != nil {
@@ -93,6 +104,20 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 
 	name := d.Id()
+	if diags := checkDeletionProtection(d, "project"); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("on_destroy").(string) == "abandon" {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Project was not deleted",
+				Detail:   fmt.Sprintf("The project %s was removed from the Terraform state but still exists in Doppler because `on_destroy` is set to `abandon`.", name),
+			},
+		}
+	}
+
 	if err := client.DeleteProject(ctx, name); err != nil {
 		return diag.FromErr(err)
 	}
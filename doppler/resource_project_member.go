@@ -2,6 +2,8 @@ package doppler
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +19,9 @@ type ResourceProjectMemberBuilder struct {
 	// Any additional schema fields for the resource
 	DataSchema map[string]*schema.Schema
 
+	// The schema field which holds the member slug
+	MemberSlugField string
+
 	// A function which uses the resource data to return a member slug
 	GetMemberSlugFunc ResourceProjectMemberGetMemberSlugFunc
 }
@@ -54,6 +59,9 @@ func (builder ResourceProjectMemberBuilder) Build() *schema.Resource {
 		CreateContext: builder.CreateContextFunc(),
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: builder.UpdateContextFunc(),
+		Importer: &schema.ResourceImporter{
+			StateContext: builder.ImportStateContextFunc(),
+		},
 		DeleteContext: builder.DeleteContextFunc(),
 		Schema:        resourceSchema,
 	}
@@ -159,7 +167,11 @@ func (builder ResourceProjectMemberBuilder) ReadContextFunc() schema.ReadContext
 
 		member, err := client.GetProjectMember(ctx, project, memberType, memberSlug)
 		if err != nil {
-			return handleNotFoundError(err, d)
+			return handleNotFoundError(err, d, m)
+		}
+
+		if err = d.Set("project", project); err != nil {
+			return diag.FromErr(err)
 		}
 
 		err = updateProjectMemberState(d, member)
@@ -171,6 +183,33 @@ func (builder ResourceProjectMemberBuilder) ReadContextFunc() schema.ReadContext
 	}
 }
 
+func (builder ResourceProjectMemberBuilder) ImportStateContextFunc() schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		// Either <project>/<slug>, or the ID stored in state: <project>.<member-type>.<slug>
+		parts, ok := splitImportId(d.Id(), 2)
+		if !ok {
+			if stateParts, isStateId := splitImportId(d.Id(), 3); isStateId && stateParts[1] == builder.MemberType {
+				parts, ok = []string{stateParts[0], stateParts[2]}, true
+			}
+		}
+		if !ok {
+			memberSlugField := strings.ReplaceAll(builder.MemberSlugField, "_", "-")
+			return nil, fmt.Errorf("Project member id not in the format <project-name>/<%s> or <project-name>.%s.<%s>", memberSlugField, builder.MemberType, memberSlugField)
+		}
+		project, memberSlug := parts[0], parts[1]
+
+		if err := d.Set("project", project); err != nil {
+			return nil, err
+		}
+		if err := d.Set(builder.MemberSlugField, memberSlug); err != nil {
+			return nil, err
+		}
+		d.SetId(getProjectMemberId(project, builder.MemberType, memberSlug))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func updateProjectMemberState(d *schema.ResourceData, projectMember *ProjectMember) error {
 	if err := d.Set("role", projectMember.Role.Identifier); err != nil {
 		return err
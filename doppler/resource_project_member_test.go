@@ -0,0 +1,53 @@
+package doppler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+)
+
+func TestResourceProjectMemberImportId(t *testing.T) {
+	tests := []struct {
+		resource   string
+		id         string
+		slugField  string
+		expectedId string
+		valid      bool
+	}{
+		{resource: "doppler_project_member_group", id: "backend/admins", slugField: "group_slug", expectedId: "backend.group.admins", valid: true},
+		{resource: "doppler_project_member_group", id: "backend.group.admins", slugField: "group_slug", expectedId: "backend.group.admins", valid: true},
+		{resource: "doppler_project_member_service_account", id: "backend.service_account.ci", slugField: "service_account_slug", expectedId: "backend.service_account.ci", valid: true},
+		{resource: "doppler_project_member_user", id: "backend/user_1", slugField: "user_slug", expectedId: "backend.workplace_user.user_1", valid: true},
+		{resource: "doppler_project_member_user", id: "backend.workplace_user.user_1", slugField: "user_slug", expectedId: "backend.workplace_user.user_1", valid: true},
+		{resource: "doppler_project_member_group", id: "backend.service_account.ci", valid: false},
+		{resource: "doppler_project_member_group", id: "backend", valid: false},
+		{resource: "doppler_project_member_group", id: "backend/group/admins/extra", valid: false},
+	}
+	for _, test := range tests {
+		t.Run(test.resource+" "+test.id, func(t *testing.T) {
+			r := doppler.Provider().ResourcesMap[test.resource]
+			d := r.Data(nil)
+			d.SetId(test.id)
+			imported, err := r.Importer.StateContext(context.Background(), d, nil)
+			if !test.valid {
+				if err == nil {
+					t.Errorf("Expected %q to be rejected", test.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id := imported[0].Id(); id != test.expectedId {
+				t.Errorf("Expected ID %q, got %q", test.expectedId, id)
+			}
+			if project := imported[0].Get("project").(string); project != "backend" {
+				t.Errorf("Unexpected project %q", project)
+			}
+			if slug := imported[0].Get(test.slugField).(string); slug == "" {
+				t.Errorf("Expected %s to be set", test.slugField)
+			}
+		})
+	}
+}
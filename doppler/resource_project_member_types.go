@@ -8,7 +8,8 @@ import (
 
 func resourceProjectMemberGroup() *schema.Resource {
 	builder := ResourceProjectMemberBuilder{
-		MemberType: "group",
+		MemberType:      "group",
+		MemberSlugField: "group_slug",
 		DataSchema: map[string]*schema.Schema{
 			"group_slug": {
 				Description: "The slug of the Doppler group",
@@ -28,7 +29,8 @@ func resourceProjectMemberGroup() *schema.Resource {
 
 func resourceProjectMemberServiceAccount() *schema.Resource {
 	builder := ResourceProjectMemberBuilder{
-		MemberType: "service_account",
+		MemberType:      "service_account",
+		MemberSlugField: "service_account_slug",
 		DataSchema: map[string]*schema.Schema{
 			"service_account_slug": {
 				Description: "The slug of the Doppler service account",
@@ -48,7 +50,8 @@ func resourceProjectMemberServiceAccount() *schema.Resource {
 
 func resourceProjectMemberUser() *schema.Resource {
 	builder := ResourceProjectMemberBuilder{
-		MemberType: "workplace_user",
+		MemberType:      "workplace_user",
+		MemberSlugField: "user_slug",
 		DataSchema: map[string]*schema.Schema{
 			"user_slug": {
 				Description: "The slug of the Doppler workplace user",
@@ -113,7 +113,7 @@ func resourceProjectRoleRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	role, err := client.GetProjectRole(ctx, identifier)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	diags = append(diags, updateProjectRoleData(d, role)...)
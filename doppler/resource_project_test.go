@@ -0,0 +1,48 @@
+package doppler_test
+
+import (
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestResourceProjectOnDestroy(t *testing.T) {
+	tests := []struct {
+		name      string
+		onDestroy string
+		deleted   bool
+	}{
+		{name: "default", deleted: true},
+		{name: "delete", onDestroy: "delete", deleted: true},
+		{name: "abandon", onDestroy: "abandon", deleted: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := dopplertest.NewServer()
+			defer server.Close()
+			p := newTestProvider(t, server, nil)
+
+			config := map[string]interface{}{"name": "backend"}
+			if test.onDestroy != "" {
+				config["on_destroy"] = test.onDestroy
+			}
+			state, diags := applyResource(t, p, "doppler_project", nil, config)
+			if diags.HasError() {
+				t.Fatalf("Create failed: %v", diags)
+			}
+
+			diags = destroyResource(t, p, "doppler_project", state)
+			if diags.HasError() {
+				t.Fatalf("Delete failed: %v", diags)
+			}
+			if warned := len(diags) == 1 && diags[0].Severity == diag.Warning; warned == test.deleted {
+				t.Errorf("Expected a warning only when the project is abandoned, got %v", diags)
+			}
+			_, err := testClient(p).GetProject(t.Context(), "backend")
+			if deleted := err != nil; deleted != test.deleted {
+				t.Errorf("Expected deleted=%t, got error %v", test.deleted, err)
+			}
+		})
+	}
+}
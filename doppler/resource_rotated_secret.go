@@ -121,7 +121,7 @@ func (builder ResourceRotatedSecretBuilder) ReadContextFunc() schema.ReadContext
 
 		rs, err := client.GetRotatedSecret(ctx, config, project, slug)
 		if err != nil {
-			return handleNotFoundError(err, d)
+			return handleNotFoundError(err, d, m)
 		}
 
 		if err = d.Set("integration", rs.Integration.Slug); err != nil {
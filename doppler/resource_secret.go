@@ -16,7 +16,7 @@ func resourceSecret() *schema.Resource {
 		CreateContext: resourceSecretUpdate,
 		ReadContext:   resourceSecretRead,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateCompositeId(3),
 		},
 		UpdateContext: resourceSecretUpdate,
 		DeleteContext: resourceSecretDelete,
@@ -36,16 +36,22 @@ func resourceSecret() *schema.Resource {
 				ForceNew: true,
 			},
 			"name": {
-				Description: "The name of the Doppler secret",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The name of the Doppler secret. Names may only contain letters, numbers, and underscores, cannot start with a number, cannot use the reserved `DOPPLER_` prefix, and may be at most 256 characters.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSecretName,
 			},
 			"value": {
-				Description: "The raw secret value",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:      "The raw secret value. Secret references (e.g. `${config.SECRET_NAME}`) are validated during plan. Exactly one of `value` or `generate` must be set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"value", "generate"},
+				ValidateDiagFunc: validateSecretReferences,
 			},
+			"generate": secretGenerateSchema(),
+			"keepers":  secretKeepersSchema(),
 			"visibility": {
 				Description:  "The visibility of the secret. One of `masked`, `unmasked`, or `restricted`. Defaults to `masked`.",
 				Type:         schema.TypeString,
@@ -59,8 +65,27 @@ func resourceSecret() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"note": {
+				Description: "A note describing the secret. Notes are shared by all configs in the project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"skip_value_refresh": {
+				Description: "Whether to skip reading the secret's value during refresh. When enabled, refresh only checks that the secret exists and reads its metadata, so the token does not need permission to read plaintext values. Changes made to the value outside of Terraform will not be detected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				// Implicitly defaults to false but not defined here to avoid state migration
+			},
+			"reject_external_changes": {
+				Description:   "Whether updates should fail instead of overwriting the secret's value when it was changed outside of Terraform since the last refresh. Defaults to `false`. Note that with `-refresh=false`, the last refresh may be older than the plan.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"skip_value_refresh"},
+			},
+			"deletion_protection": deletionProtectionSchema(),
+			"token":               tokenOverrideSchema(),
 			"value_type": {
-				Description: "The value type of the secret",
+				Description: "The value type of the secret. The value is validated against the type during plan.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "string",
@@ -71,14 +96,18 @@ func resourceSecret() *schema.Resource {
 				}, false),
 			},
 		},
-		CustomizeDiff: customdiff.ComputedIf("computed", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("value")
-		}),
+		CustomizeDiff: customdiff.All(
+			customizeDiffSecretGenerate,
+			customdiff.ComputedIf("computed", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("value")
+			}),
+			customizeDiffSecretValueType,
+		),
 	}
 }
 
 func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(APIClient)
+	client := getAPIClient(d, m)
 
 	var diags diag.Diagnostics
 	project := d.Get("project").(string)
@@ -88,6 +117,14 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	visibility := d.Get("visibility").(string)
 	valueType := d.Get("value_type").(string)
 
+	if options := secretGenerateOptions(d); options != nil && (d.IsNewResource() || d.HasChange("generate") || d.HasChange("keepers")) {
+		generated, err := generateSecretValue(options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		value = generated
+	}
+
 	changeRequest := ChangeRequest{
 		Name:       name,
 		Value:      &value,
@@ -98,27 +135,51 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		previousNameValue, _ := d.GetChange("name")
 		previousName := previousNameValue.(string)
 		changeRequest.OriginalName = &previousName
+
+		// Terraform refreshes the state before making an update, so by default the update pushes over
+		// any external change. When opted in, the value in state (as of the last refresh) is sent so the
+		// Doppler API rejects the update if the secret has changed since.
+		if d.Get("reject_external_changes").(bool) {
+			previousValueValue, _ := d.GetChange("value")
+			previousValue := previousValueValue.(string)
+			changeRequest.OriginalValue = &previousValue
+		}
 	} else {
 		changeRequest.OriginalName = &name
 	}
 
-	// NOTE: We could set `OriginalValue` here to leverage staleness detection in the Doppler API.
-	// However, Terraform already confirms the existing state before making an update.
-	// We'll skip the API-level staleness checking to allow Terraform to push over an external change.
-
-	if err := client.UpdateSecrets(ctx, project, config, []ChangeRequest{changeRequest}); err != nil {
+	configSecretsMutex.Lock(getSecretsId(project, config))
+	err := client.UpdateSecrets(ctx, project, config, []ChangeRequest{changeRequest})
+	configSecretsMutex.Unlock(getSecretsId(project, config))
+	// Only the original value check can be diagnosed, other conflicts are reported as returned by the API
+	if changeRequest.OriginalValue != nil && isConflictError(err) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Secret %s was modified outside of Terraform", name),
+				Detail:   "The secret's value in Doppler no longer matches the value Terraform last refreshed, so it was not overwritten because `reject_external_changes` is enabled. Run `terraform plan` again to review the change made outside of Terraform.",
+			},
+		}
+	}
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(getSecretId(project, config, name))
 
+	if d.HasChange("note") {
+		if err := client.UpdateSecretNote(ctx, project, config, name, d.Get("note").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	readDiags := resourceSecretRead(ctx, d, m)
 	diags = append(diags, readDiags...)
 	return diags
 }
 
 func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(APIClient)
+	client := getAPIClient(d, m)
 
 	var diags diag.Diagnostics
 
@@ -130,9 +191,11 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	secret, err := client.GetSecret(ctx, project, config, name)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
+	skipValueRefresh := d.Get("skip_value_refresh").(bool)
+
 	nilFields := []string{}
 	if secret.Value.Raw == nil {
 		nilFields = append(nilFields, "raw")
@@ -141,11 +204,12 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		nilFields = append(nilFields, "computed")
 	}
 
-	if len(nilFields) > 0 {
+	if len(nilFields) > 0 && !skipValueRefresh {
 		return diag.FromErr(fmt.Errorf(
 			"One or more secret fields are restricted: %v. "+
 				"You must use a service account or service token to manage these resources. "+
-				"Otherwise, Terraform cannot fetch these restricted secrets to check the validity of their state.", nilFields))
+				"Otherwise, Terraform cannot fetch these restricted secrets to check the validity of their state. "+
+				"Alternatively, set `skip_value_refresh` to skip reading the secret's value.", nilFields))
 	}
 
 	if err = d.Set("project", project); err != nil {
@@ -160,12 +224,15 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
-	if err = d.Set("value", secret.Value.Raw); err != nil {
-		return diag.FromErr(err)
-	}
+	// Keep the values from the configuration and the last apply when skipping value refresh
+	if !skipValueRefresh {
+		if err = d.Set("value", secret.Value.Raw); err != nil {
+			return diag.FromErr(err)
+		}
 
-	if err = d.Set("computed", secret.Value.Computed); err != nil {
-		return diag.FromErr(err)
+		if err = d.Set("computed", secret.Value.Computed); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if err = d.Set("visibility", secret.Value.RawVisibility); err != nil {
@@ -176,14 +243,24 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	if secret.Value.Note != nil {
+		if err = d.Set("note", *secret.Value.Note); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return diags
 }
 
 func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(APIClient)
+	client := getAPIClient(d, m)
 
 	var diags diag.Diagnostics
 
+	if diags := checkDeletionProtection(d, "secret"); diags.HasError() {
+		return diags
+	}
+
 	secretId := d.Id()
 	tokens := strings.Split(secretId, ".")
 	if len(tokens) != 3 {
@@ -195,7 +272,10 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	name := tokens[2]
 
 	changeRequest := ChangeRequest{OriginalName: &name, Name: name, ShouldDelete: true}
-	if err := client.UpdateSecrets(ctx, project, config, []ChangeRequest{changeRequest}); err != nil {
+	configSecretsMutex.Lock(getSecretsId(project, config))
+	err := client.UpdateSecrets(ctx, project, config, []ChangeRequest{changeRequest})
+	configSecretsMutex.Unlock(getSecretsId(project, config))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -0,0 +1,239 @@
+package doppler_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+const secretsPath = "/v3/configs/config/secrets"
+
+func secretConfig(value string) map[string]interface{} {
+	return map[string]interface{}{
+		"project": "backend",
+		"config":  "dev",
+		"name":    "API_KEY",
+		"value":   value,
+	}
+}
+
+func TestResourceSecretLifecycle(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	state, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if state.ID != "backend.dev.API_KEY" {
+		t.Errorf("Unexpected ID %q", state.ID)
+	}
+	if state.Attributes["value"] != "one" || state.Attributes["computed"] != "one" {
+		t.Errorf("Unexpected attributes after create: %v", state.Attributes)
+	}
+
+	state, diags = applyResource(t, p, "doppler_secret", state, secretConfig("two"))
+	if diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	secret, err := testClient(p).GetSecret(t.Context(), "backend", "dev", "API_KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *secret.Value.Raw != "two" {
+		t.Errorf("Expected the updated value in Doppler, got %q", *secret.Value.Raw)
+	}
+
+	if diff := planResource(t, p, "doppler_secret", state, secretConfig("two")); diff != nil && !diff.Empty() {
+		t.Errorf("Expected no changes after apply, got %v", diff)
+	}
+
+	if diags := destroyResource(t, p, "doppler_secret", state); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+	if _, err := testClient(p).GetSecret(t.Context(), "backend", "dev", "API_KEY"); err == nil {
+		t.Error("Expected the secret to be deleted")
+	}
+
+	// A secret deleted outside of Terraform is removed from state
+	refreshed, diags := refreshResource(t, p, "doppler_secret", state)
+	if diags.HasError() {
+		t.Fatalf("Refresh failed: %v", diags)
+	}
+	if refreshed != nil && refreshed.ID != "" {
+		t.Errorf("Expected the deleted secret to be removed from state, got %v", refreshed)
+	}
+}
+
+func TestResourceSecretRetriesRateLimitedRequests(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	server.FailNext(http.MethodPost, secretsPath, 2, http.StatusTooManyRequests, map[string]string{"retry-after": "0"})
+	state, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if requests := server.Requests(http.MethodPost, secretsPath); requests != 3 {
+		t.Errorf("Expected 2 retries of the update, got %d requests", requests)
+	}
+	if state.Attributes["value"] != "one" {
+		t.Errorf("Unexpected value %q", state.Attributes["value"])
+	}
+}
+
+func TestResourceSecretDoesNotRetryNonIdempotentGatewayErrors(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	// The update may have been applied before the gateway failed, so it must not be sent again
+	server.FailNext(http.MethodPost, secretsPath, 1, http.StatusBadGateway, nil)
+	if _, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one")); !diags.HasError() {
+		t.Fatal("Expected the create to fail")
+	}
+	if requests := server.Requests(http.MethodPost, secretsPath); requests != 1 {
+		t.Errorf("Expected a single update request, got %d", requests)
+	}
+}
+
+func TestResourceSecretRetriesIdempotentGatewayErrors(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	state, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	server.FailNext(http.MethodGet, "/v3/configs/config/secret", 2, http.StatusServiceUnavailable, nil)
+	refreshed, diags := refreshResource(t, p, "doppler_secret", state)
+	if diags.HasError() {
+		t.Fatalf("Refresh failed: %v", diags)
+	}
+	if refreshed.Attributes["value"] != "one" {
+		t.Errorf("Unexpected value %q", refreshed.Attributes["value"])
+	}
+}
+
+func TestResourceSecretGivesUpAfterMaxRetries(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, map[string]interface{}{"max_retries": 3})
+	createTestConfig(t, p, "backend", "dev")
+
+	server.FailNext(http.MethodPost, secretsPath, 5, http.StatusTooManyRequests, map[string]string{"retry-after": "0"})
+	if _, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one")); !diags.HasError() {
+		t.Fatal("Expected the create to fail")
+	}
+	if requests := server.Requests(http.MethodPost, secretsPath); requests != 3 {
+		t.Errorf("Expected 3 attempts, got %d", requests)
+	}
+}
+
+// setSecretExternally changes a secret's value as if it was edited in the dashboard
+func setSecretExternally(t *testing.T, client doppler.APIClient, value string) {
+	t.Helper()
+	name := "API_KEY"
+	change := doppler.ChangeRequest{Name: name, OriginalName: &name, Value: &value}
+	if err := client.UpdateSecrets(t.Context(), "backend", "dev", []doppler.ChangeRequest{change}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResourceSecretOverwritesExternalChangesByDefault(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	state, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	// Applying without refreshing (e.g. `-refresh=false`) pushes over the external change
+	setSecretExternally(t, testClient(p), "external")
+	if _, diags := applyResource(t, p, "doppler_secret", state, secretConfig("two")); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	secret, err := testClient(p).GetSecret(t.Context(), "backend", "dev", "API_KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *secret.Value.Raw != "two" {
+		t.Errorf("Expected the external change to be overwritten, got %q", *secret.Value.Raw)
+	}
+}
+
+func TestResourceSecretRejectExternalChanges(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	config := secretConfig("one")
+	config["reject_external_changes"] = true
+	state, diags := applyResource(t, p, "doppler_secret", nil, config)
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	setSecretExternally(t, testClient(p), "external")
+	config["value"] = "two"
+	_, diags = applyResource(t, p, "doppler_secret", state, config)
+	if !diags.HasError() {
+		t.Fatal("Expected the update to be rejected")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "was modified outside of Terraform") {
+		t.Errorf("Unexpected diagnostic %q", summary)
+	}
+	secret, err := testClient(p).GetSecret(t.Context(), "backend", "dev", "API_KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *secret.Value.Raw != "external" {
+		t.Errorf("Expected the external change to be kept, got %q", *secret.Value.Raw)
+	}
+
+	// Once refreshed, the update is applied over the reviewed change
+	state, diags = refreshResource(t, p, "doppler_secret", state)
+	if diags.HasError() {
+		t.Fatalf("Refresh failed: %v", diags)
+	}
+	if _, diags := applyResource(t, p, "doppler_secret", state, config); diags.HasError() {
+		t.Fatalf("Update after refresh failed: %v", diags)
+	}
+}
+
+func TestResourceSecretReportsOtherConflicts(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	state, diags := applyResource(t, p, "doppler_secret", nil, secretConfig("one"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	// Without reject_external_changes, a conflict isn't caused by an external change to the value
+	server.FailNext(http.MethodPost, secretsPath, 1, http.StatusConflict, nil)
+	_, diags = applyResource(t, p, "doppler_secret", state, secretConfig("two"))
+	if !diags.HasError() {
+		t.Fatal("Expected the update to fail")
+	}
+	if summary := diags[0].Summary; strings.Contains(summary, "was modified outside of Terraform") || !strings.Contains(summary, "Conflict") {
+		t.Errorf("Expected the API error to be reported, got %q", summary)
+	}
+}
@@ -135,7 +135,7 @@ func resourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, m i
 
 	serviceAccount, err := client.GetServiceAccount(ctx, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	err = updateServiceAccountState(d, serviceAccount)
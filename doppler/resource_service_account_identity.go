@@ -3,10 +3,13 @@ package doppler
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceServiceAccountIdentity() *schema.Resource {
@@ -18,6 +21,15 @@ func resourceServiceAccountIdentity() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceAccountIdentityImport,
 		},
+		CustomizeDiff: customizeDiffServiceAccountIdentityClaims,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceServiceAccountIdentityV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceServiceAccountIdentityStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"service_account_slug": {
 				Description: "Slug of the service account",
@@ -36,9 +48,20 @@ func resourceServiceAccountIdentity() *schema.Resource {
 				Required:    true,
 			},
 			"ttl_seconds": {
-				Description: "The amount of time, in seconds, that auth tokens for this identity will be valid",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Description:  "The amount of time, in seconds, that auth tokens for this identity will be valid. Must be between 60 (1 minute) and 86400 (24 hours).",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"created_at": {
+				Description: "The datetime that the identity was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_authenticated_at": {
+				Description: "The datetime that a token was last issued for the identity. Empty if the identity has never been used.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"config_oidc": {
 				Description: "The OIDC configuration for the identity",
@@ -55,15 +78,17 @@ func resourceServiceAccountIdentity() *schema.Resource {
 var resourceServiceAccountIdentityConfigOidc = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"discovery_url": {
-			Description: "The public URL of the OpenID discovery service",
-			Type:        schema.TypeString,
-			Required:    true,
+			Description:      "The public URL of the OpenID discovery service",
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressTrailingSlashDiff,
 		},
 		"claims_type": {
-			Description: "If \"wildcard\", wildcard characters will be expanded during claims validation. Defaults to \"exact\"",
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "exact",
+			Description:  "If \"wildcard\", wildcard characters will be expanded during claims validation. Either \"exact\" or \"wildcard\". Defaults to \"exact\"",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "exact",
+			ValidateFunc: validation.StringInSlice([]string{"exact", "wildcard"}, false),
 		},
 		"claims": {
 			Description: "A set of valid values for a specific claim. At least \"aud\" and \"sub\" must be provided",
@@ -78,9 +103,10 @@ var resourceServiceAccountIdentityConfigOidc = schema.Resource{
 var resourceServiceAccountIdentityConfigOidcClaims = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"key": {
-			Description: "The key of the claim to validate",
-			Type:        schema.TypeString,
-			Required:    true,
+			Description:  "The key of the claim to validate",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateNoSurroundingWhitespace,
 		},
 		"values": {
 			Description: "The set of valid values for this claim",
@@ -88,16 +114,57 @@ var resourceServiceAccountIdentityConfigOidcClaims = schema.Resource{
 			MinItems:    1,
 			Required:    true,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateNoSurroundingWhitespace,
 			},
 		},
 	},
 }
 
+// The API normalizes claim keys and values, so surrounding whitespace would cause a perpetual diff
+var validateNoSurroundingWhitespace = validation.StringMatch(regexp.MustCompile(`^\S(.*\S)?$`), "must not be empty or have leading or trailing whitespace")
+
+// suppressTrailingSlashDiff treats URLs which differ only by a trailing slash as equal
+func suppressTrailingSlashDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return strings.TrimSuffix(oldValue, "/") == strings.TrimSuffix(newValue, "/")
+}
+
+// The claims which every OIDC identity must validate
+var requiredOidcClaims = []string{"aud", "sub"}
+
+func customizeDiffServiceAccountIdentityClaims(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	oidcConfigList, ok := d.Get("config_oidc").([]interface{})
+	if !ok || len(oidcConfigList) == 0 || oidcConfigList[0] == nil {
+		return nil
+	}
+	oidcConfig := oidcConfigList[0].(map[string]interface{})
+	claims, ok := oidcConfig["claims"].(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	keys := map[string]bool{}
+	for _, cc := range claims.List() {
+		key := cc.(map[string]interface{})["key"].(string)
+		if key == "" {
+			// The key isn't known until apply
+			return nil
+		}
+		keys[key] = true
+	}
+
+	for _, required := range requiredOidcClaims {
+		if !keys[required] {
+			return fmt.Errorf("config_oidc.claims must include a claim with key %q; the required claim keys are %s", required, strings.Join(requiredOidcClaims, ", "))
+		}
+	}
+	return nil
+}
+
 func resourceServiceAccountIdentityImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	split := strings.Split(d.Id(), `.`)
-	if len(split) != 2 {
-		return []*schema.ResourceData{d}, errors.New("Service account identity id not in the format <service-account-slug>.<service-account-identity-slug>")
+	split, ok := splitImportId(d.Id(), 2)
+	if !ok {
+		return []*schema.ResourceData{d}, errors.New("Service account identity id not in the format <service-account-slug>/<service-account-identity-slug>")
 	}
 	if err := d.Set("service_account_slug", split[0]); err != nil {
 		return []*schema.ResourceData{d}, err
@@ -137,7 +204,7 @@ func resourceServiceAccountIdentityRead(ctx context.Context, d *schema.ResourceD
 
 	id, err := client.GetServiceAccountIdentity(ctx, serviceAccount, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	diags = updateServiceAccountIdentityState(d, serviceAccount, &id, diags)
@@ -161,6 +228,7 @@ func resourceServiceAccountIdentityUpdate(ctx context.Context, d *schema.Resourc
 		return diags
 	}
 
+	// The identity's slug is stable across renames, but the state ID is taken from the response in case that ever changes
 	diags = updateServiceAccountIdentityState(d, serviceAccountSlug, id, diags)
 	return diags
 }
@@ -227,6 +295,18 @@ func updateServiceAccountIdentityState(d *schema.ResourceData, serviceAccountSlu
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	if err := d.Set("created_at", id.CreatedAt); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	lastAuthenticatedAt := ""
+	if id.LastAuthenticatedAt != nil {
+		lastAuthenticatedAt = *id.LastAuthenticatedAt
+	}
+	if err := d.Set("last_authenticated_at", lastAuthenticatedAt); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	switch id.Method {
 	case "oidc":
 		claimSet := schema.NewSet(schema.HashResource(&resourceServiceAccountIdentityConfigOidcClaims), make([]interface{}, 0))
@@ -243,9 +323,15 @@ func updateServiceAccountIdentityState(d *schema.ResourceData, serviceAccountSlu
 			claimSet.Add(claims)
 		}
 
+		// Treat a missing claims type as the default so imported identities have a valid `claims_type`
+		claimsType := id.ConfigOidc.ClaimsType
+		if claimsType == "" {
+			claimsType = "exact"
+		}
+
 		configOidc := map[string]interface{}{
 			"discovery_url": id.ConfigOidc.DiscoveryUrl,
-			"claims_type":   id.ConfigOidc.ClaimsType,
+			"claims_type":   claimsType,
 			"claims":        claimSet,
 		}
 
@@ -255,7 +341,7 @@ func updateServiceAccountIdentityState(d *schema.ResourceData, serviceAccountSlu
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	default:
-		diags = append(diags, diag.FromErr(errors.New("Unknown auth method type"))...)
+		diags = append(diags, diag.FromErr(fmt.Errorf("Unknown auth method type %q", id.Method))...)
 	}
 
 	d.SetId(id.Slug)
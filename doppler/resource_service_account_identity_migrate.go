@@ -0,0 +1,87 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceServiceAccountIdentityV0 is the schema of doppler_service_account_identity before SchemaVersion was introduced.
+// It must not be changed; later schema changes should add a new version and upgrader instead.
+func resourceServiceAccountIdentityV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service_account_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl_seconds": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"config_oidc": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"discovery_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"claims_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"claims": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceServiceAccountIdentityStateUpgradeV0 fills in the `claims_type` default for identities
+// whose state was written before the attribute was validated.
+func resourceServiceAccountIdentityStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	configOidcList, ok := rawState["config_oidc"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+	for _, rawConfigOidc := range configOidcList {
+		configOidc, ok := rawConfigOidc.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if claimsType, _ := configOidc["claims_type"].(string); claimsType == "" {
+			configOidc["claims_type"] = "exact"
+		}
+	}
+	return rawState, nil
+}
@@ -0,0 +1,69 @@
+package doppler_test
+
+import (
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+func identityConfig(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"service_account_slug": "sa_1",
+		"name":                 name,
+		"ttl_seconds":          3600,
+		"config_oidc": []interface{}{
+			map[string]interface{}{
+				"discovery_url": "https://token.actions.githubusercontent.com",
+				"claims": []interface{}{
+					map[string]interface{}{"key": "aud", "values": []interface{}{"https://github.com/acme"}},
+					map[string]interface{}{"key": "sub", "values": []interface{}{"repo:acme/backend:ref:refs/heads/main"}},
+				},
+			},
+		},
+	}
+}
+
+func TestResourceServiceAccountIdentityUpdateSendsFullIdentity(t *testing.T) {
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+
+	state, diags := applyResource(t, p, "doppler_service_account_identity", nil, identityConfig("ci"))
+	if diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	slug := state.ID
+
+	// The TTL is changed outside of Terraform after the last refresh
+	external, err := testClient(p).GetServiceAccountIdentity(t.Context(), "sa_1", slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	external.TtlSeconds = 600
+	if _, err := testClient(p).UpdateServiceAccountIdentity(t.Context(), "sa_1", &external); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates replace the whole identity with the configuration
+	state, diags = applyResource(t, p, "doppler_service_account_identity", state, identityConfig("ci-renamed"))
+	if diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	if state.ID != slug {
+		t.Errorf("Expected the slug to be stable across renames, got %q instead of %q", state.ID, slug)
+	}
+
+	identity, err := testClient(p).GetServiceAccountIdentity(t.Context(), "sa_1", slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Name != "ci-renamed" {
+		t.Errorf("Expected the identity to be renamed, got %q", identity.Name)
+	}
+	if identity.TtlSeconds != 3600 {
+		t.Errorf("Expected the configured TTL to be sent with the update, got %d", identity.TtlSeconds)
+	}
+	if identity.Method != "oidc" || identity.ConfigOidc.DiscoveryUrl != "https://token.actions.githubusercontent.com" || len(identity.ConfigOidc.Claims) != 2 {
+		t.Errorf("Expected the OIDC config to be sent with the update, got %+v", identity.ConfigOidc)
+	}
+}
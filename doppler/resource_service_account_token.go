@@ -2,6 +2,7 @@ package doppler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +13,9 @@ func resourceServiceAccountToken() *schema.Resource {
 		CreateContext: resourceServiceAccountTokenCreate,
 		ReadContext:   resourceServiceAccountTokenRead,
 		DeleteContext: resourceServiceAccountTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAccountTokenImport,
+		},
 		// ForceNew is specified for all user-specified fields
 		// Service account tokens cannot be moved, renamed, or edited to change their access
 		Schema: map[string]*schema.Schema{
@@ -35,9 +39,10 @@ func resourceServiceAccountToken() *schema.Resource {
 			"expires_at": {
 				Description: "The datetime at which the API token should expire. " +
 					"If not provided, the API token will remain valid indefinitely unless manually revoked",
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentTimes,
 			},
 			"created_at": {
 				Description: "The datetime that the token was created.",
@@ -54,6 +59,19 @@ func resourceServiceAccountToken() *schema.Resource {
 	}
 }
 
+func resourceServiceAccountTokenImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split, ok := splitImportId(d.Id(), 2)
+	if !ok {
+		return nil, errors.New("Service account token id not in the format <service-account-slug>/<service-account-token-slug>")
+	}
+	if err := d.Set("service_account_slug", split[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(split[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
@@ -97,7 +115,7 @@ func resourceServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData
 
 	token, err := client.GetServiceAccountToken(ctx, serviceAccount, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	if err = d.Set("name", token.ServiceAccountToken.Name); err != nil {
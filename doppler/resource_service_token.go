@@ -2,6 +2,8 @@ package doppler
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +15,9 @@ func resourceServiceToken() *schema.Resource {
 		CreateContext: resourceServiceTokenCreate,
 		ReadContext:   resourceServiceTokenRead,
 		DeleteContext: resourceServiceTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateCompositeId(3),
+		},
 		// ForceNew is specified for all user-specified fields
 		// Service tokens cannot be moved, renamed, or edited to change their access
 		Schema: map[string]*schema.Schema{
@@ -35,13 +40,31 @@ func resourceServiceToken() *schema.Resource {
 				ForceNew:    true,
 			},
 			"access": {
-				Description:  "The access level (read or read/write)",
+				Description:  "The access level of the service token, either `read` or `read/write`. Defaults to `read`. The access level of an existing service token cannot be changed, so changing it creates a new token (with a new `key`) and revokes the old one.",
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "read",
 				ValidateFunc: validation.StringInSlice([]string{"read", "read/write"}, false),
 				ForceNew:     true,
 			},
+			"expires_at": {
+				Description: "The datetime (RFC 3339) at which the service token expires. " +
+					"If neither `expires_at` nor `ttl` is provided, the service token remains valid indefinitely unless manually revoked.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"ttl"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"ttl": {
+				Description:  "The duration (e.g. `720h`) after creation at which the service token expires. The resulting expiration is available in `expires_at`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDuration,
+			},
 			"key": {
 				Description: "The key for the Doppler service token",
 				Type:        schema.TypeString,
@@ -60,8 +83,16 @@ func resourceServiceTokenCreate(ctx context.Context, d *schema.ResourceData, m i
 	config := d.Get("config").(string)
 	access := d.Get("access").(string)
 	name := d.Get("name").(string)
+	expireAt := d.Get("expires_at").(string)
+	if ttl := d.Get("ttl").(string); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		expireAt = time.Now().UTC().Add(duration).Format(time.RFC3339)
+	}
 
-	token, err := client.CreateServiceToken(ctx, project, config, access, name)
+	token, err := client.CreateServiceToken(ctx, project, config, access, name, expireAt)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -72,6 +103,10 @@ func resourceServiceTokenCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("expires_at", serviceTokenExpiresAt(token, expireAt)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -86,7 +121,7 @@ func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m int
 
 	tokens, err := client.GetServiceTokens(ctx, project, config)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	var token *ServiceToken
@@ -99,7 +134,7 @@ func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m int
 
 	if token == nil {
 		err := &CustomNotFoundError{Message: "Could not find requested service token"}
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	if err = d.Set("project", token.Project); err != nil {
@@ -110,10 +145,18 @@ func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("name", token.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set("access", token.Access); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("expires_at", serviceTokenExpiresAt(token, d.Get("expires_at").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// `key` cannot be read after initial creation
 
 	return diags
@@ -134,3 +177,27 @@ func resourceServiceTokenDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	return diags
 }
+
+// serviceTokenExpiresAt returns the expiration of the token, falling back to the requested expiration
+// if the API didn't return one
+func serviceTokenExpiresAt(token *ServiceToken, fallback string) string {
+	if token.ExpiresAt != nil {
+		return *token.ExpiresAt
+	}
+	return fallback
+}
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration (e.g. `720h`): %s", k, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%s must be a positive duration", k)}
+	}
+	return nil, nil
+}
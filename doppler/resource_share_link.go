@@ -0,0 +1,84 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceShareLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceShareLinkCreate,
+		ReadContext:   resourceShareLinkRead,
+		DeleteContext: resourceShareLinkDelete,
+		Schema: map[string]*schema.Schema{
+			"value": {
+				Description: "The secret value to share",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"expire_views": {
+				Description:  "The number of times the link can be viewed before it expires (1-50). Defaults to `1`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"expire_days": {
+				Description:  "The number of days until the link expires (1-90). Defaults to `1`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 90),
+			},
+			"url": {
+				Description: "The share link. The link includes the password needed to decrypt the secret.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceShareLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	value := d.Get("value").(string)
+	expireViews := d.Get("expire_views").(int)
+	expireDays := d.Get("expire_days").(int)
+
+	link, err := client.CreateShareLink(ctx, value, expireViews, expireDays)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The link itself is sensitive, so it isn't used as the resource ID
+	d.SetId(id.UniqueId())
+
+	if err = d.Set("url", link.AuthenticatedURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceShareLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Share links can't be read back from the API, so the link recorded at creation is kept in state.
+	return diags
+}
+
+func resourceShareLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Share links can't be revoked through the API; they expire on their own.
+	return diags
+}
@@ -2,8 +2,11 @@ package doppler
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,10 +15,13 @@ type SyncDataBuilderFunc = func(d *schema.ResourceData) SyncData
 type SyncDataReaderFunc = func(data map[string]interface{}, d *schema.ResourceData) error
 
 type ResourceSyncBuilder struct {
-	DataSchema    map[string]*schema.Schema
-	DataBuilder   IntegrationDataBuilderFunc
-	DataReader    SyncDataReaderFunc
-	CustomizeDiff schema.CustomizeDiffFunc
+	DataSchema  map[string]*schema.Schema
+	DataBuilder IntegrationDataBuilderFunc
+	DataReader  SyncDataReaderFunc
+	// ImportDataReader populates the data attributes when a sync is imported.
+	// Defaults to DataReader, or to reading each data attribute with a matching key in the sync data.
+	ImportDataReader SyncDataReaderFunc
+	CustomizeDiff    schema.CustomizeDiffFunc
 }
 
 // resourceSync returns a schema resource object for the Sync model.
@@ -46,6 +52,27 @@ func (builder ResourceSyncBuilder) Build() *schema.Resource {
 			// Implicitly defaults to "leave_in_target" but not defined here to avoid state migration
 			ValidateFunc: validation.StringInSlice([]string{"leave_in_target", "delete_from_target"}, false),
 		},
+		"wait_for_sync": {
+			Description: "Whether to wait for the initial sync to complete before the resource is considered created. The wait is bounded by the `create` timeout.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			// Implicitly defaults to false but not defined here to avoid state migration
+		},
+		"enabled": {
+			Description: "Whether the sync is enabled. Doppler disables syncs that repeatedly fail (e.g. due to broken integration credentials).",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"last_sync_status": {
+			Description: "The status of the sync: `pending` (no sync has completed yet), `synced`, or `disabled`. The Doppler API doesn't report the outcome or errors of individual sync runs, so the status is derived from `enabled` and `last_synced_at`, and a sync that fails is only reported once Doppler disables it.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_synced_at": {
+			Description: "The time the sync last completed",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 	}
 
 	for name, subschema := range builder.DataSchema {
@@ -58,8 +85,14 @@ func (builder ResourceSyncBuilder) Build() *schema.Resource {
 		ReadContext:   builder.ReadContextFunc(),
 		UpdateContext: resourceSyncUpdate,
 		DeleteContext: builder.DeleteContextFunc(),
+		Importer: &schema.ResourceImporter{
+			StateContext: builder.ImportStateContextFunc(),
+		},
 		Schema:        resourceSchema,
 		CustomizeDiff: builder.CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -78,27 +111,119 @@ func (builder ResourceSyncBuilder) CreateContextFunc() schema.CreateContextFunc
 			return diag.FromErr(err)
 		}
 
-		d.SetId(sync.Slug)
+		d.SetId(sync.getResourceId())
 
+		if d.Get("wait_for_sync").(bool) {
+			if err := waitForSync(ctx, client, d.Timeout(schema.TimeoutCreate), config, project, sync.Slug); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		readDiags := builder.ReadContextFunc()(ctx, d, m)
+		diags = append(diags, readDiags...)
 		return diags
 	}
 }
 
+func (builder ResourceSyncBuilder) ImportStateContextFunc() schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		client := m.(APIClient)
+
+		parts, ok := splitImportId(d.Id(), 3)
+		if !ok {
+			return nil, fmt.Errorf("Unexpected import ID format %q, expected <project>/<config>/<sync-slug>", d.Id())
+		}
+		project, config, slug := parts[0], parts[1], parts[2]
+
+		sync, err := client.GetSync(ctx, config, project, slug)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(sync.getResourceId())
+		if err := d.Set("project", sync.Project); err != nil {
+			return nil, err
+		}
+		if err := d.Set("config", sync.Config); err != nil {
+			return nil, err
+		}
+
+		// Data attributes are ForceNew, so they must match the configuration to avoid replacing
+		// (and potentially deleting the secrets in) the imported sync
+		reader := builder.ImportDataReader
+		if reader == nil {
+			reader = builder.DataReader
+		}
+		if reader == nil {
+			reader = builder.readDataSchemaKeys
+		}
+		if sync.Data != nil {
+			if err := reader(sync.Data, d); err != nil {
+				return nil, fmt.Errorf("Unable to read the data of sync %s: %w", slug, err)
+			}
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// readDataSchemaKeys sets each data attribute with a matching key in the sync data. The API returns the
+// data as it was sent when the sync was created, so attributes that weren't sent are set to their default.
+func (builder ResourceSyncBuilder) readDataSchemaKeys(data map[string]interface{}, d *schema.ResourceData) error {
+	for key, subschema := range builder.DataSchema {
+		v, ok := data[key]
+		if !ok || v == nil {
+			v = subschema.Default
+		}
+		if v == nil {
+			continue
+		}
+		if err := d.Set(key, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func waitForSync(ctx context.Context, client APIClient, timeout time.Duration, config, project, slug string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"synced"},
+		Refresh: func() (interface{}, string, error) {
+			sync, err := client.GetSync(ctx, config, project, slug)
+			if err != nil {
+				return nil, "", err
+			}
+			return sync, sync.Status(), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for sync %s to complete: %w", slug, err)
+	}
+	return nil
+}
+
 func (builder ResourceSyncBuilder) ReadContextFunc() schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(APIClient)
 
 		var diags diag.Diagnostics
 
-		name := d.Id()
-		config := d.Get("config").(string)
-		project := d.Get("project").(string)
+		project, config, slug, err := syncIdentifiers(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-		sync, err := client.GetSync(ctx, config, project, name)
+		sync, err := client.GetSync(ctx, config, project, slug)
 		if err != nil {
-			return handleNotFoundError(err, d)
+			return handleNotFoundError(err, d, m)
 		}
 
+		// Upgrade legacy slug-only IDs to the structured form
+		d.SetId(sync.getResourceId())
+
 		if err = d.Set("integration", sync.Integration); err != nil {
 			return diag.FromErr(err)
 		}
@@ -111,6 +236,30 @@ func (builder ResourceSyncBuilder) ReadContextFunc() schema.ReadContextFunc {
 			return diag.FromErr(err)
 		}
 
+		if err = d.Set("enabled", sync.Enabled); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("last_sync_status", sync.Status()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !sync.Enabled {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Sync is disabled",
+				Detail:   fmt.Sprintf("The sync %s from %s/%s is disabled and is no longer updating its target. Doppler disables syncs that repeatedly fail, which usually means the integration's credentials or permissions are broken. Check the sync in the Doppler dashboard.", sync.Slug, sync.Project, sync.Config),
+			})
+		}
+
+		lastSyncedAt := ""
+		if sync.LastSyncedAt != nil {
+			lastSyncedAt = *sync.LastSyncedAt
+		}
+		if err = d.Set("last_synced_at", lastSyncedAt); err != nil {
+			return diag.FromErr(err)
+		}
+
 		if builder.DataReader != nil && sync.Data != nil {
 			if err = builder.DataReader(sync.Data, d); err != nil {
 				return diag.FromErr(err)
@@ -121,9 +270,25 @@ func (builder ResourceSyncBuilder) ReadContextFunc() schema.ReadContextFunc {
 	}
 }
 
+// syncIdentifiers returns the project, config, and slug of the sync, falling back to the
+// resource attributes for legacy slug-only IDs.
+func syncIdentifiers(d *schema.ResourceData) (project string, config string, slug string, err error) {
+	project, config, slug, err = parseSyncResourceId(d.Id())
+	if err != nil {
+		return "", "", "", err
+	}
+	if project == "" {
+		project = d.Get("project").(string)
+	}
+	if config == "" {
+		config = d.Get("config").(string)
+	}
+	return project, config, slug, nil
+}
+
 func resourceSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	// This function must be specified in order to update `delete_behavior` but no API operations are required.
+	// This function must be specified in order to update `delete_behavior` and `wait_for_sync` but no API operations are required.
 	// All other fields require `ForceNew`.
 	return diags
 }
@@ -135,9 +300,10 @@ func (builder ResourceSyncBuilder) DeleteContextFunc() schema.DeleteContextFunc
 
 		var diags diag.Diagnostics
 
-		slug := d.Id()
-		config := d.Get("config").(string)
-		project := d.Get("project").(string)
+		project, config, slug, err := syncIdentifiers(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		// NOTE: `delete_behavior` might be null, this logic will treat that as `leave_in_target`
 		deleteFromTarget := d.Get("delete_behavior").(string) == "delete_from_target"
 		if err := client.DeleteSync(ctx, slug, deleteFromTarget, config, project); err != nil {
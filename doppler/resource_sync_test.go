@@ -0,0 +1,78 @@
+package doppler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/dopplertest"
+)
+
+// TestResourceSyncImport checks that importing a sync reads back every data attribute, since they are
+// ForceNew and a difference would replace the sync (and possibly delete the secrets in its target)
+func TestResourceSyncImport(t *testing.T) {
+	tests := []struct {
+		resource string
+		data     map[string]interface{}
+	}{
+		{resource: "doppler_secrets_sync_aws_secrets_manager", data: map[string]interface{}{"region": "us-east-1", "path": "/backend/"}},
+		{resource: "doppler_secrets_sync_aws_secrets_manager", data: map[string]interface{}{
+			"region": "us-east-1", "path": "/backend/", "kms_key_id": "alias/doppler", "tags": map[string]interface{}{"team": "backend"},
+			"update_metadata": true, "update_resource_tags": "upsert", "name_transform": "lower-kebab", "path_behavior": "none", "sync_strategy": "multi-secret",
+		}},
+		{resource: "doppler_secrets_sync_aws_parameter_store", data: map[string]interface{}{"region": "us-east-1", "path": "/backend/"}},
+		{resource: "doppler_secrets_sync_aws_parameter_store", data: map[string]interface{}{
+			"region": "us-east-1", "path": "/backend/", "secure_string": false, "advanced_parameter": true, "kms_key_id": "alias/doppler",
+			"tags": map[string]interface{}{"team": "backend"}, "update_resource_tags": "replace", "name_transform": "lower-kebab", "sync_strategy": "single-secret",
+		}},
+		{resource: "doppler_secrets_sync_circleci", data: map[string]interface{}{"resource_type": "project", "resource_id": "backend", "organization_slug": "acme"}},
+		{resource: "doppler_secrets_sync_terraform_cloud", data: map[string]interface{}{"sync_target": "workspace", "workspace_id": "ws-123", "variable_sync_type": "env", "name_transform": "none"}},
+		{resource: "doppler_secrets_sync_terraform_cloud", data: map[string]interface{}{"sync_target": "variableSet", "variable_set_id": "varset-123", "variable_sync_type": "terraform", "name_transform": "lowercase"}},
+		{resource: "doppler_secrets_sync_github_actions", data: map[string]interface{}{"sync_target": "repo", "repo_name": "backend"}},
+		{resource: "doppler_secrets_sync_github_actions", data: map[string]interface{}{"sync_target": "repo", "repo_name": "backend", "environment_name": "production", "sync_unmasked_as_variables": true}},
+		{resource: "doppler_secrets_sync_github_actions", data: map[string]interface{}{"sync_target": "org", "org_scope": "private"}},
+		{resource: "doppler_secrets_sync_github_codespaces", data: map[string]interface{}{"sync_target": "repo", "repo_name": "backend"}},
+		{resource: "doppler_secrets_sync_github_dependabot", data: map[string]interface{}{"sync_target": "org", "org_scope": "all"}},
+		{resource: "doppler_secrets_sync_flyio", data: map[string]interface{}{"app_id": "backend", "restart_machines": true}},
+		{resource: "doppler_secrets_sync_digitalocean_app", data: map[string]interface{}{"app_id": "app-123"}},
+		{resource: "doppler_secrets_sync_digitalocean_app", data: map[string]interface{}{"app_id": "app-123", "component": "api"}},
+		{resource: "doppler_secrets_sync_azure_vault", data: map[string]interface{}{"sync_strategy": "multi-secret", "vault_uri": "https://backend.vault.azure.net/"}},
+		{resource: "doppler_secrets_sync_azure_vault", data: map[string]interface{}{"sync_strategy": "single-secret", "vault_uri": "https://backend.vault.azure.net/", "single_secret_name": "backend"}},
+		{resource: "doppler_secrets_sync_gcp_secret_manager", data: map[string]interface{}{"sync_strategy": "multi-secret", "regions": []interface{}{"automatic"}}},
+		{resource: "doppler_secrets_sync_gcp_secret_manager", data: map[string]interface{}{"sync_strategy": "single-secret", "name": "backend", "format": "env", "regions": []interface{}{"us-east1"}}},
+		{resource: "doppler_secrets_sync_vercel", data: map[string]interface{}{"project_id": "prj_123", "target_id": "production"}},
+		{resource: "doppler_secrets_sync_vercel", data: map[string]interface{}{"team_id": "team_123", "project_id": "prj_123", "target_id": "preview", "variable_type": "sensitive"}},
+		{resource: "doppler_secrets_sync_supabase", data: map[string]interface{}{"project_id": "abcdefghijklmnop"}},
+	}
+
+	server := dopplertest.NewServer()
+	defer server.Close()
+	p := newTestProvider(t, server, nil)
+	createTestConfig(t, p, "backend", "dev")
+
+	for _, test := range tests {
+		t.Run(test.resource, func(t *testing.T) {
+			config := map[string]interface{}{"integration": "integration_1", "project": "backend", "config": "dev"}
+			for key, value := range test.data {
+				config[key] = value
+			}
+			created, diags := applyResource(t, p, test.resource, nil, config)
+			if diags.HasError() {
+				t.Fatalf("Create failed: %v", diags)
+			}
+
+			importId := strings.ReplaceAll(created.ID, ".", "/")
+			imported, diags := importResource(t, p, test.resource, importId)
+			if diags.HasError() {
+				t.Fatalf("Import failed: %v", diags)
+			}
+			if imported.ID != created.ID {
+				t.Errorf("Expected the imported ID to be %q, got %q", created.ID, imported.ID)
+			}
+			if diff := planResource(t, p, test.resource, imported, config); diff != nil && !diff.Empty() {
+				for key, attribute := range diff.Attributes {
+					t.Errorf("Expected no changes after import, %s changes from %q to %q", key, attribute.Old, attribute.New)
+				}
+			}
+		})
+	}
+}
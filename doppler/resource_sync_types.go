@@ -143,6 +143,15 @@ func resourceSyncAWSSecretsManager() *schema.Resource {
 			return payload
 		},
 	}
+	builder.ImportDataReader = func(data map[string]interface{}, d *schema.ResourceData) error {
+		if err := builder.readDataSchemaKeys(data, d); err != nil {
+			return err
+		}
+		if useDopplerSuffix, ok := data["use_doppler_suffix"].(bool); ok && !useDopplerSuffix {
+			return d.Set("path_behavior", "none")
+		}
+		return nil
+	}
 	return builder.Build()
 }
 
@@ -530,6 +539,36 @@ func resourceSyncFlyio() *schema.Resource {
 	return builder.Build()
 }
 
+func resourceSyncDigitalOceanApp() *schema.Resource {
+	builder := ResourceSyncBuilder{
+		DataSchema: map[string]*schema.Schema{
+			"app_id": {
+				Description: "The ID of the DigitalOcean App Platform app to sync to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"component": {
+				Description: "The name of the app component (e.g. a service or worker) to sync to. If not provided, secrets are synced as app-level environment variables, which are shared by all components.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+		DataBuilder: func(d *schema.ResourceData) IntegrationData {
+			payload := map[string]interface{}{
+				"app_id": d.Get("app_id"),
+			}
+			component := d.Get("component")
+			if component != "" {
+				payload["component"] = component
+			}
+			return payload
+		},
+	}
+	return builder.Build()
+}
+
 func resourceSyncAzureVault() *schema.Resource {
 	vault_uri_regex, _ := regexp.Compile("^https://.*/$")
 	single_secret_name_regex, _ := regexp.Compile("^[a-zA-Z0-9-]{1,127}$")
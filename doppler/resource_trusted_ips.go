@@ -0,0 +1,158 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Doppler allows access from any IP address unless a config's trusted IPs are restricted
+const allowAllTrustedIP = "0.0.0.0/0"
+
+func resourceTrustedIPs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrustedIPsUpdate,
+		ReadContext:   resourceTrustedIPsRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateCompositeId(2),
+		},
+		UpdateContext: resourceTrustedIPsUpdate,
+		DeleteContext: resourceTrustedIPsDelete,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name of the Doppler project",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Description: "The name of the Doppler config",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ips": {
+				Description: "The IP addresses and CIDR ranges allowed to access the config's secrets. Use `0.0.0.0/0` to allow access from any IP address.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+			},
+		},
+	}
+}
+
+func resourceTrustedIPsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project := d.Get("project").(string)
+	config := d.Get("config").(string)
+
+	desiredIPs := map[string]bool{}
+	for _, ip := range d.Get("ips").(*schema.Set).List() {
+		desiredIPs[ip.(string)] = true
+	}
+
+	currentIPs, err := client.GetTrustedIPs(ctx, project, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existingIPs := map[string]bool{}
+	for _, ip := range currentIPs {
+		existingIPs[ip] = true
+	}
+
+	// Add the new IPs before removing the old ones so the config is never left without any trusted IPs
+	for ip := range desiredIPs {
+		if !existingIPs[ip] {
+			if err := client.AddTrustedIP(ctx, project, config, ip); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	for ip := range existingIPs {
+		if !desiredIPs[ip] {
+			if err := client.DeleteTrustedIP(ctx, project, config, ip); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId(getSecretsId(project, config))
+
+	readDiags := resourceTrustedIPsRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceTrustedIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project, config, err := parseSecretsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ips, err := client.GetTrustedIPs(ctx, project, config)
+	if err != nil {
+		return handleNotFoundError(err, d, m)
+	}
+
+	if err = d.Set("project", project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("config", config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("ips", ips); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTrustedIPsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	project, config, err := parseSecretsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentIPs, err := client.GetTrustedIPs(ctx, project, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Restore Doppler's default of allowing access from any IP address
+	hasAllowAll := false
+	for _, ip := range currentIPs {
+		if ip == allowAllTrustedIP {
+			hasAllowAll = true
+		}
+	}
+	if !hasAllowAll {
+		if err := client.AddTrustedIP(ctx, project, config, allowAllTrustedIP); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for _, ip := range currentIPs {
+		if ip != allowAllTrustedIP {
+			if err := client.DeleteTrustedIP(ctx, project, config, ip); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return diags
+}
@@ -2,10 +2,12 @@ package doppler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceWebhook() *schema.Resource {
@@ -14,6 +16,10 @@ func resourceWebhook() *schema.Resource {
 		ReadContext:   resourceWebhookRead,
 		UpdateContext: resourceWebhookUpdate,
 		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWebhookImport,
+		},
+		CustomizeDiff: customizeDiffWebhookAuthentication,
 		Schema: map[string]*schema.Schema{
 			"slug": {
 				Description: "The slug of the Webhook",
@@ -54,22 +60,27 @@ func resourceWebhook() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Description:  "The authentication type. One of `None`, `Bearer`, or `Basic`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"None", "Bearer", "Basic"}, false),
 						},
 						"token": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Description: "The token sent in the `Authorization: Bearer` header. Required for `Bearer` authentication.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
 						},
 						"username": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Description: "The username used for `Basic` authentication",
+							Type:        schema.TypeString,
+							Optional:    true,
 						},
 						"password": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Description: "The password used for `Basic` authentication",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
 						},
 					},
 				},
@@ -94,6 +105,42 @@ func resourceWebhook() *schema.Resource {
 	}
 }
 
+// customizeDiffWebhookAuthentication ensures the credentials required by the authentication type are set
+func customizeDiffWebhookAuthentication(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	authType, ok := d.GetOk("authentication.0.type")
+	if !ok {
+		return nil
+	}
+	// Credentials that aren't known until apply (e.g. generated passwords) can't be checked
+	isMissing := func(key string) bool {
+		return d.NewValueKnown(key) && d.Get(key).(string) == ""
+	}
+	switch authType.(string) {
+	case "Bearer":
+		if isMissing("authentication.0.token") {
+			return errors.New("`authentication.token` is required when the authentication type is `Bearer`")
+		}
+	case "Basic":
+		if isMissing("authentication.0.username") || isMissing("authentication.0.password") {
+			return errors.New("`authentication.username` and `authentication.password` are required when the authentication type is `Basic`")
+		}
+	}
+	return nil
+}
+
+func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split, ok := splitImportId(d.Id(), 2)
+	if !ok {
+		return nil, errors.New("Webhook id not in the format <project-name>/<webhook-slug>")
+	}
+	if err := d.Set("project", split[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(split[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(APIClient)
 
@@ -229,7 +276,11 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	webhook, err := client.GetWebhook(ctx, project, slug)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
+	}
+
+	if err = d.Set("project", project); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("slug", webhook.Slug); err != nil {
@@ -113,7 +113,7 @@ func resourceWorkplaceRoleRead(ctx context.Context, d *schema.ResourceData, m in
 
 	role, err := client.GetWorkplaceRole(ctx, identifier)
 	if err != nil {
-		return handleNotFoundError(err, d)
+		return handleNotFoundError(err, d, m)
 	}
 
 	diags = append(diags, updateWorkplaceRoleData(d, role)...)
@@ -0,0 +1,93 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkplaceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkplaceSettingsUpdate,
+		ReadContext:   resourceWorkplaceSettingsRead,
+		UpdateContext: resourceWorkplaceSettingsUpdate,
+		DeleteContext: resourceWorkplaceSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the workplace",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"billing_email": {
+				Description: "The email address that receives billing notifications",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"security_email": {
+				Description: "The email address that receives security notifications",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkplaceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	name := d.Get("name").(string)
+	billingEmail := d.Get("billing_email").(string)
+	securityEmail := d.Get("security_email").(string)
+
+	workplace, err := client.UpdateWorkplace(ctx, name, billingEmail, securityEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workplace.ID)
+
+	readDiags := resourceWorkplaceSettingsRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceWorkplaceSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	workplace, err := client.GetWorkplace(ctx)
+	if err != nil {
+		return handleNotFoundError(err, d, m)
+	}
+
+	d.SetId(workplace.ID)
+
+	if err = d.Set("name", workplace.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("billing_email", workplace.BillingEmail); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("security_email", workplace.SecurityEmail); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// The workplace can't be deleted through the API, so destroying this resource only removes it from state.
+	return diags
+}
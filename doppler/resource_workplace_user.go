@@ -0,0 +1,141 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWorkplaceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkplaceUserCreate,
+		ReadContext:   resourceWorkplaceUserRead,
+		UpdateContext: resourceWorkplaceUserUpdate,
+		DeleteContext: resourceWorkplaceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address of an existing Doppler workplace user",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access": {
+				Description: "The workplace access level of the user. One of `admin`, `collaborator`, `viewer`, or `no_access`. " +
+					"The workplace owner cannot be managed with this resource.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "collaborator", "viewer", "no_access"}, false),
+			},
+			"deletion_protection": deletionProtectionSchema(),
+			"slug": {
+				Description: "The slug of the Doppler workplace user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the Doppler user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the Doppler user",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkplaceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+	email := d.Get("email").(string)
+	access := d.Get("access").(string)
+
+	// Users join the workplace by accepting an invite, so this resource adopts an existing user
+	user, err := client.GetWorkplaceUser(ctx, email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err = client.UpdateWorkplaceUserAccess(ctx, user.Slug, access); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(user.Slug)
+
+	readDiags := resourceWorkplaceUserRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceWorkplaceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	user, err := client.GetWorkplaceUserBySlug(ctx, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, m)
+	}
+
+	if err = d.Set("email", user.User.Email); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("access", user.Access); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("slug", user.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("name", user.User.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("username", user.User.Username); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkplaceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	if d.HasChange("access") {
+		if _, err := client.UpdateWorkplaceUserAccess(ctx, d.Id(), d.Get("access").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceWorkplaceUserRead(ctx, d, m)
+	diags = append(diags, readDiags...)
+	return diags
+}
+
+func resourceWorkplaceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(APIClient)
+
+	var diags diag.Diagnostics
+
+	if diags := checkDeletionProtection(d, "workplace user"); diags.HasError() {
+		return diags
+	}
+
+	if err := client.DeleteWorkplaceUser(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,130 @@
+package doppler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+type cachedResponse struct {
+	ETag string
+	Body []byte
+}
+
+// inflightRequest is a request that concurrent callers with the same key wait on instead of issuing their own.
+type inflightRequest struct {
+	done chan struct{}
+	body []byte
+	err  error
+	// Whether the request failed because the context of the caller that sent it was cancelled
+	cancelled bool
+}
+
+// responseCache stores response bodies by ETag so repeated reads can be served from a `304 Not Modified` response.
+// Identical requests that are in flight at the same time are coalesced into a single request.
+// It is shared by copies of the API client and is only held in memory for the lifetime of the provider.
+type responseCache struct {
+	mu       sync.Mutex
+	entries  map[string]cachedResponse
+	inflight map[string]*inflightRequest
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: map[string]cachedResponse{}, inflight: map[string]*inflightRequest{}}
+}
+
+// responseCacheKey identifies a request. The token is included since different tokens may have different access.
+func responseCacheKey(token string, path string, params []QueryParam) string {
+	parts := []string{token, path}
+	for _, param := range params {
+		parts = append(parts, param.Key+"="+param.Value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+func (c *responseCache) get(key string) (cachedResponse, bool) {
+	if c == nil {
+		return cachedResponse{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *responseCache) set(key string, response *APIResponse) {
+	if c == nil || response == nil || response.HTTPResponse == nil {
+		return
+	}
+	etag := response.HTTPResponse.Header.Get("etag")
+	if etag == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cachedResponse{ETag: etag, Body: response.Body}
+}
+
+// coalesce runs fn, unless a call with the same key is already in flight, in which case it waits for and
+// returns that call's result. Waiting stops when ctx is done, and the call is run again if the one in flight
+// only failed because its own caller's context was cancelled.
+func (c *responseCache) coalesce(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	if c == nil {
+		return fn()
+	}
+	for {
+		c.mu.Lock()
+		if request, ok := c.inflight[key]; ok {
+			c.mu.Unlock()
+			select {
+			case <-request.done:
+			case <-ctx.Done():
+				return nil, &APIError{Err: ctx.Err(), Message: "Unable to send request"}
+			}
+			if request.cancelled {
+				continue
+			}
+			return request.body, request.err
+		}
+		request := &inflightRequest{done: make(chan struct{})}
+		c.inflight[key] = request
+		c.mu.Unlock()
+
+		request.body, request.err = fn()
+		request.cancelled = request.err != nil && ctx.Err() != nil
+
+		c.mu.Lock()
+		delete(c.inflight, key)
+		c.mu.Unlock()
+		close(request.done)
+		return request.body, request.err
+	}
+}
+
+// performCachedGet performs a GET request, sending the cached ETag (if any) and returning the cached body when the
+// API responds with `304 Not Modified`. Identical concurrent requests share a single API call.
+func (client APIClient) performCachedGet(ctx context.Context, path string, params []QueryParam) ([]byte, error) {
+	key := responseCacheKey(client.APIKey, path, params)
+	return client.responseCache.coalesce(ctx, key, func() ([]byte, error) {
+		return client.performConditionalGet(ctx, key, path, params)
+	})
+}
+
+func (client APIClient) performConditionalGet(ctx context.Context, key string, path string, params []QueryParam) ([]byte, error) {
+	cached, isCached := client.responseCache.get(key)
+
+	var headers map[string]string
+	if isCached {
+		headers = map[string]string{"If-None-Match": cached.ETag}
+	}
+	response, err := client.performRequestWithRetry(ctx, "GET", path, params, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	if isCached && response.HTTPResponse.StatusCode == http.StatusNotModified {
+		return cached.Body, nil
+	}
+	client.responseCache.set(key, response)
+	return response.Body, nil
+}
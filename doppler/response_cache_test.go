@@ -0,0 +1,119 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startLeader runs a coalesced call which blocks until release is closed, and waits for it to be in flight
+func startLeader(t *testing.T, cache *responseCache, ctx context.Context, release chan struct{}, result chan error) {
+	t.Helper()
+	started := make(chan struct{})
+	go func() {
+		_, err := cache.coalesce(ctx, "key", func() ([]byte, error) {
+			close(started)
+			select {
+			case <-release:
+				return []byte("leader"), nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		})
+		result <- err
+	}()
+	<-started
+}
+
+func TestCoalesceSharesResult(t *testing.T) {
+	cache := newResponseCache()
+	release := make(chan struct{})
+	leaderResult := make(chan error, 1)
+	startLeader(t, cache, t.Context(), release, leaderResult)
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	bodies := make([][]byte, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bodies[i], _ = cache.coalesce(t.Context(), "key", func() ([]byte, error) {
+				calls.Add(1)
+				return []byte("waiter"), nil
+			})
+		}()
+	}
+	// Give the waiters time to find the call in flight
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if err := <-leaderResult; err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected the waiters to share the call in flight, got %d extra calls", calls.Load())
+	}
+	for _, body := range bodies {
+		if string(body) != "leader" {
+			t.Errorf("Expected the shared result, got %q", body)
+		}
+	}
+}
+
+func TestCoalesceWaiterStopsWhenCancelled(t *testing.T) {
+	cache := newResponseCache()
+	release := make(chan struct{})
+	defer close(release)
+	leaderResult := make(chan error, 1)
+	startLeader(t, cache, t.Context(), release, leaderResult)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := cache.coalesce(ctx, "key", func() ([]byte, error) {
+		t.Error("Expected the call in flight to be waited on")
+		return nil, nil
+	})
+	var apiError *APIError
+	if !errors.As(err, &apiError) || !errors.Is(apiError.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected the waiter's deadline to be reported, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the waiter to stop at its deadline, waited %s", elapsed)
+	}
+}
+
+func TestCoalesceRetriesWhenLeaderCancelled(t *testing.T) {
+	cache := newResponseCache()
+	leaderCtx, cancelLeader := context.WithCancel(t.Context())
+	release := make(chan struct{})
+	defer close(release)
+	leaderResult := make(chan error, 1)
+	startLeader(t, cache, leaderCtx, release, leaderResult)
+
+	waiterResult := make(chan []byte, 1)
+	go func() {
+		body, err := cache.coalesce(t.Context(), "key", func() ([]byte, error) {
+			return []byte("waiter"), nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		waiterResult <- body
+	}()
+	// Give the waiter time to find the call in flight
+	time.Sleep(50 * time.Millisecond)
+	cancelLeader()
+
+	if err := <-leaderResult; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the leader to be cancelled, got %v", err)
+	}
+	if body := <-waiterResult; string(body) != "waiter" {
+		t.Errorf("Expected the waiter to run the call itself, got %q", body)
+	}
+}
@@ -0,0 +1,137 @@
+package doppler
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	generateLowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	generateUpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	generateNumericChars = "0123456789"
+	// `$` is excluded since `${` starts a secret reference
+	generateSpecialChars = "!@#%&*()-_=+[]{}<>:?"
+)
+
+func secretGenerateSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "Generate a random value for the secret instead of setting `value`. The value is generated by the provider when the secret is created and regenerated when the `generate` block or `keepers` change, so the plaintext never needs to be passed in from another resource.",
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"value", "generate"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"length": {
+					Description:  "The length of the generated value. Defaults to `32`.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      32,
+					ValidateFunc: validation.IntBetween(1, 4096),
+				},
+				"lower": {
+					Description: "Whether to include lowercase letters. Defaults to `true`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"upper": {
+					Description: "Whether to include uppercase letters. Defaults to `true`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"numeric": {
+					Description: "Whether to include numbers. Defaults to `true`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"special": {
+					Description: "Whether to include symbols. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"override_special": {
+					Description: "The symbols to use when `special` is enabled, instead of the default set (`" + generateSpecialChars + "`)",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func secretKeepersSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Arbitrary values which regenerate the secret's value when changed. Only used with `generate`.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// generateSecretValue returns a random value built from the options of a `generate` block
+func generateSecretValue(options map[string]interface{}) (string, error) {
+	charset := ""
+	if options["lower"].(bool) {
+		charset += generateLowerChars
+	}
+	if options["upper"].(bool) {
+		charset += generateUpperChars
+	}
+	if options["numeric"].(bool) {
+		charset += generateNumericChars
+	}
+	if options["special"].(bool) {
+		if overrideSpecial := options["override_special"].(string); overrideSpecial != "" {
+			charset += overrideSpecial
+		} else {
+			charset += generateSpecialChars
+		}
+	}
+	if charset == "" {
+		return "", fmt.Errorf("At least one of `lower`, `upper`, `numeric`, or `special` must be enabled to generate a secret value")
+	}
+
+	length := options["length"].(int)
+	value := make([]byte, length)
+	max := big.NewInt(int64(len(charset)))
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("Unable to generate secret value: %w", err)
+		}
+		value[i] = charset[n.Int64()]
+	}
+	return string(value), nil
+}
+
+// secretGenerateOptions returns the options of the `generate` block, or nil if the value isn't generated
+func secretGenerateOptions(d interface{ Get(string) interface{} }) map[string]interface{} {
+	generate := d.Get("generate").([]interface{})
+	if len(generate) == 0 || generate[0] == nil {
+		return nil
+	}
+	return generate[0].(map[string]interface{})
+}
+
+// customizeDiffSecretGenerate marks the value as unknown when a generated value will be regenerated
+func customizeDiffSecretGenerate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if secretGenerateOptions(d) == nil {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("generate") && !d.HasChange("keepers") {
+		return nil
+	}
+	if err := d.SetNewComputed("value"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("computed")
+}
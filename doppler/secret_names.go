@@ -0,0 +1,61 @@
+package doppler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Secret names with this prefix are reserved for the secrets Doppler injects (e.g. `DOPPLER_CONFIG`)
+const reservedSecretNamePrefix = "DOPPLER_"
+
+// The longest secret name Doppler accepts
+const maxSecretNameLength = 256
+
+var secretNameDisallowedCharsRegex = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// secretNameProblem returns why the name isn't a valid secret name, or an empty string if it is valid.
+func secretNameProblem(name string) string {
+	if name == "" {
+		return "Secret names cannot be empty."
+	}
+	if len(name) > maxSecretNameLength {
+		return fmt.Sprintf("The secret name %q is %d characters long. Secret names may be at most %d characters.", name, len(name), maxSecretNameLength)
+	}
+	if chars := secretNameDisallowedCharsRegex.FindAllString(name, -1); len(chars) > 0 {
+		quoted := []string{}
+		for _, char := range chars {
+			quoted = append(quoted, fmt.Sprintf("%q", char))
+		}
+		return fmt.Sprintf("The secret name %q contains disallowed characters: %s. Secret names may only contain letters, numbers, and underscores.", name, strings.Join(quoted, ", "))
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return fmt.Sprintf("The secret name %q starts with a number. Secret names must start with a letter or underscore.", name)
+	}
+	if strings.HasPrefix(strings.ToUpper(name), reservedSecretNamePrefix) {
+		return fmt.Sprintf("The secret name %q uses the reserved prefix %s, which is used for the secrets Doppler provides automatically.", name, reservedSecretNamePrefix)
+	}
+	return ""
+}
+
+func validateSecretName(i interface{}, path cty.Path) diag.Diagnostics {
+	name, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of name to be string")
+	}
+	problem := secretNameProblem(name)
+	if problem == "" {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid secret name",
+			Detail:        problem + " The `provider::doppler::normalize_name` function can convert arbitrary strings into valid secret names.",
+			AttributePath: path,
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package doppler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSecretNameProblem(t *testing.T) {
+	tests := []struct {
+		name    string
+		problem string
+	}{
+		{name: "API_KEY"},
+		{name: "_2FA_SECRET"},
+		{name: "api_key"},
+		{name: "_DOPPLER_X"},
+		{name: "DOPPLER"},
+		{name: "", problem: "cannot be empty"},
+		{name: "API-KEY", problem: `disallowed characters: "-"`},
+		{name: "MY KEY.2", problem: `disallowed characters: " ", "."`},
+		{name: "2FA", problem: "starts with a number"},
+		{name: "DOPPLER_X", problem: "reserved prefix"},
+		{name: "doppler_x", problem: "reserved prefix"},
+		{name: strings.Repeat("A", maxSecretNameLength)},
+		{name: strings.Repeat("A", maxSecretNameLength+1), problem: "at most 256 characters"},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%.32s", test.name), func(t *testing.T) {
+			problem := secretNameProblem(test.name)
+			if test.problem == "" && problem != "" {
+				t.Errorf("Expected %q to be valid, got %q", test.name, problem)
+			}
+			if test.problem != "" && !strings.Contains(problem, test.problem) {
+				t.Errorf("Expected the problem with %q to mention %q, got %q", test.name, test.problem, problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package doppler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// A secret reference is `${SECRET}`, `${config.SECRET}`, or `${project.config.SECRET}`
+var secretReferenceRegex = regexp.MustCompile(`^(?:[A-Za-z0-9_-]+\.){0,2}[A-Za-z_][A-Za-z0-9_]*$`)
+
+// findInvalidSecretReferences returns the references in a secret value which aren't valid secret references.
+// A `${` without a closing brace isn't a reference, so it is left as literal text.
+func findInvalidSecretReferences(value string) []string {
+	invalid := []string{}
+	remaining := value
+	for {
+		start := strings.Index(remaining, "${")
+		if start == -1 {
+			return invalid
+		}
+		remaining = remaining[start+2:]
+		end := strings.Index(remaining, "}")
+		if end == -1 {
+			return invalid
+		}
+		reference := remaining[:end]
+		if nested := strings.LastIndex(reference, "${"); nested != -1 {
+			// The earlier `${` is unterminated, so only the last one before the brace is a reference
+			remaining = remaining[nested:]
+			continue
+		}
+		if !secretReferenceRegex.MatchString(reference) {
+			invalid = append(invalid, "${"+reference+"}")
+		}
+		remaining = remaining[end+1:]
+	}
+}
+
+func validateSecretReferences(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of value to be string")
+	}
+	for _, reference := range findInvalidSecretReferences(value) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid secret reference",
+			Detail:        fmt.Sprintf("The secret reference %q is not valid.", reference) + " References must use the format ${SECRET_NAME}, ${config.SECRET_NAME}, or ${project.config.SECRET_NAME}.",
+			AttributePath: path,
+		})
+	}
+	return diags
+}
@@ -0,0 +1,36 @@
+package doppler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindInvalidSecretReferences(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+	}{
+		{value: "plain value", expected: []string{}},
+		{value: "${API_KEY}", expected: []string{}},
+		{value: "postgres://${USER}:${PASSWORD}@${dev.DB_HOST}/${backend.dev.DB_NAME}", expected: []string{}},
+		{value: "${my-project.dev_personal.API_KEY}", expected: []string{}},
+		{value: "${}", expected: []string{"${}"}},
+		{value: "${API-KEY}", expected: []string{"${API-KEY}"}},
+		{value: "${a.b.c.API_KEY}", expected: []string{"${a.b.c.API_KEY}"}},
+		{value: "${2FA}", expected: []string{"${2FA}"}},
+		{value: "${OK} and ${not ok}", expected: []string{"${not ok}"}},
+		// An unterminated `${` is literal text, e.g. a template fragment
+		{value: "echo ${", expected: []string{}},
+		{value: "${API_KEY} then ${HOME", expected: []string{}},
+		{value: "${HOME then ${API_KEY}", expected: []string{}},
+		{value: "${HOME then ${NOT-OK}", expected: []string{"${NOT-OK}"}},
+		{value: "$API_KEY {x}", expected: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if invalid := findInvalidSecretReferences(test.value); !reflect.DeepEqual(invalid, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, invalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package doppler
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	integerValueRegex = regexp.MustCompile(`^-?[0-9]+$`)
+	uuidv4ValueRegex  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-4[0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`)
+	cuid2ValueRegex   = regexp.MustCompile(`^[a-z][a-z0-9]{1,31}$`)
+	ulidValueRegex    = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+)
+
+// The ISO 8601 (extended format) forms accepted for `datetime8601` values. The time and its offset are
+// optional, and fractional seconds are accepted after the seconds by time.Parse.
+var iso8601DatetimeLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04Z0700",
+	"2006-01-02T15:04Z07",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05Z07",
+}
+
+func isISO8601Datetime(value string) bool {
+	for _, layout := range iso8601DatetimeLayouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSecretValueType returns an error if the value is not valid for the secret value type.
+// Types which can't be checked locally (e.g. `yaml`, `json5`) are always considered valid.
+func validateSecretValueType(valueType string, value string) error {
+	switch valueType {
+	case "json":
+		if !json.Valid([]byte(value)) {
+			return errors.New("value is not valid JSON")
+		}
+	case "boolean":
+		if value != "true" && value != "false" {
+			return errors.New("value must be `true` or `false`")
+		}
+	case "integer":
+		if !integerValueRegex.MatchString(value) {
+			return errors.New("value is not an integer")
+		}
+	case "decimal":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("value is not a decimal number")
+		}
+	case "email":
+		if address, err := mail.ParseAddress(value); err != nil || address.Address != value {
+			return errors.New("value is not an email address")
+		}
+	case "url":
+		if parsed, err := url.ParseRequestURI(value); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return errors.New("value is not an absolute URL")
+		}
+	case "uuidv4":
+		if !uuidv4ValueRegex.MatchString(value) {
+			return errors.New("value is not a v4 UUID")
+		}
+	case "cuid2":
+		if !cuid2ValueRegex.MatchString(value) {
+			return errors.New("value is not a CUID2")
+		}
+	case "ulid":
+		if !ulidValueRegex.MatchString(value) {
+			return errors.New("value is not a ULID")
+		}
+	case "datetime8601":
+		if !isISO8601Datetime(value) {
+			return errors.New("value is not an ISO 8601 datetime")
+		}
+	case "date8601":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return errors.New("value is not an ISO 8601 date (YYYY-MM-DD)")
+		}
+	case "xml":
+		decoder := xml.NewDecoder(strings.NewReader(value))
+		for {
+			if _, err := decoder.Token(); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return errors.New("value is not valid XML")
+			}
+		}
+	}
+	return nil
+}
+
+func customizeDiffSecretValueType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("value") || !d.NewValueKnown("value_type") {
+		return nil
+	}
+	value := d.Get("value").(string)
+	valueType := d.Get("value_type").(string)
+	// References are resolved by Doppler, so only the computed value can be checked
+	if strings.Contains(value, "${") {
+		return nil
+	}
+	if err := validateSecretValueType(valueType, value); err != nil {
+		// The error intentionally doesn't include the (sensitive) value
+		return fmt.Errorf("Invalid value for value_type %q: %s", valueType, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package doppler
+
+import "testing"
+
+func TestValidateSecretValueType(t *testing.T) {
+	tests := []struct {
+		valueType string
+		value     string
+		valid     bool
+	}{
+		{valueType: "datetime8601", value: "2024-01-01", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00.123", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00Z", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00.123456Z", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00+01:00", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00+0100", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00:00+01", valid: true},
+		{valueType: "datetime8601", value: "2024-01-01T00:00Z", valid: true},
+		{valueType: "datetime8601", value: "2024-13-01", valid: false},
+		{valueType: "datetime8601", value: "2024-01-01T25:00:00Z", valid: false},
+		{valueType: "datetime8601", value: "2024-01-01 00:00:00", valid: false},
+		{valueType: "datetime8601", value: "01/01/2024", valid: false},
+		{valueType: "datetime8601", value: "", valid: false},
+		{valueType: "date8601", value: "2024-01-01", valid: true},
+		{valueType: "date8601", value: "2024-01-01T00:00:00Z", valid: false},
+		{valueType: "integer", value: "-42", valid: true},
+		{valueType: "integer", value: "4.2", valid: false},
+		{valueType: "boolean", value: "true", valid: true},
+		{valueType: "boolean", value: "True", valid: false},
+		{valueType: "json", value: `{"a": 1}`, valid: true},
+		{valueType: "json", value: `{a: 1}`, valid: false},
+		{valueType: "email", value: "user@example.com", valid: true},
+		{valueType: "email", value: "User <user@example.com>", valid: false},
+		{valueType: "url", value: "https://example.com/path", valid: true},
+		{valueType: "url", value: "example.com", valid: false},
+		{valueType: "uuidv4", value: "0b0e2a4c-7a1d-4c3e-9f51-0b8d7f0e6a2b", valid: true},
+		{valueType: "uuidv4", value: "0b0e2a4c-7a1d-1c3e-9f51-0b8d7f0e6a2b", valid: false},
+		{valueType: "xml", value: "<a><b/></a>", valid: true},
+		{valueType: "xml", value: "<a>", valid: false},
+		{valueType: "yaml", value: ": not checked", valid: true},
+	}
+	for _, test := range tests {
+		t.Run(test.valueType+" "+test.value, func(t *testing.T) {
+			err := validateSecretValueType(test.valueType, test.value)
+			if test.valid && err != nil {
+				t.Errorf("Expected %q to be a valid %s, got %s", test.value, test.valueType, err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("Expected %q to be an invalid %s", test.value, test.valueType)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package doppler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Project names, environment slugs, and config names are used as identifiers in the API and CLI
+var slugRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// slugProblem returns why the value isn't a valid slug, or an empty string if it is valid.
+func slugProblem(kind string, value string) string {
+	if value == "" {
+		return fmt.Sprintf("The %s cannot be empty.", kind)
+	}
+	if strings.ToLower(value) != value && slugRegex.MatchString(strings.ToLower(value)) {
+		return fmt.Sprintf("The %s %q contains uppercase letters. Use %q instead.", kind, value, strings.ToLower(value))
+	}
+	if !slugRegex.MatchString(value) {
+		return fmt.Sprintf("The %s %q may only contain lowercase letters, numbers, dashes, and underscores.", kind, value)
+	}
+	return ""
+}
+
+// validateSlug returns a validator for arguments which must be valid slugs, e.g. `validateSlug("project name")`
+func validateSlug(kind string) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		value, ok := i.(string)
+		if !ok {
+			return diag.Errorf("expected type of %s to be string", kind)
+		}
+		problem := slugProblem(kind, value)
+		if problem == "" {
+			return nil
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid %s", kind),
+				Detail:        problem,
+				AttributePath: path,
+			},
+		}
+	}
+}
+
+// customizeDiffConfigName checks that the config name starts with its environment's slug.
+// Root configs are named after their environment and branch configs are named `<environment>_<branch>`.
+func customizeDiffConfigName(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("name") || !d.NewValueKnown("environment") {
+		return nil
+	}
+	name := d.Get("name").(string)
+	environment := d.Get("environment").(string)
+	if name == environment || strings.HasPrefix(name, environment+"_") {
+		return nil
+	}
+	return fmt.Errorf("The config name %q must be the environment slug %q (for the root config) or start with %q (for a branch config)", name, environment, environment+"_")
+}
@@ -0,0 +1,25 @@
+package doppler
+
+import "testing"
+
+func TestSlugProblem(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "backend", expected: ""},
+		{value: "dev_personal-2", expected: ""},
+		{value: "", expected: "The project name cannot be empty."},
+		{value: "Backend", expected: `The project name "Backend" contains uppercase letters. Use "backend" instead.`},
+		{value: "back end", expected: `The project name "back end" may only contain lowercase letters, numbers, dashes, and underscores.`},
+		{value: "Back End", expected: `The project name "Back End" may only contain lowercase letters, numbers, dashes, and underscores.`},
+		{value: "backend.dev", expected: `The project name "backend.dev" may only contain lowercase letters, numbers, dashes, and underscores.`},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if problem := slugProblem("project name", test.value); problem != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,684 @@
+// Package dopplertest provides an in-memory fake of the Doppler API for exercising the provider's
+// resources without a real workplace or token.
+//
+// The fake covers OIDC token exchange, token info, projects, configs, secrets, syncs, service account
+// identities, and activity logs. Point the provider's `host` at Server.URL and use Token as the
+// `doppler_token`.
+package dopplertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
+)
+
+// Token is the only token accepted by the fake server
+const Token = "dp.pt.dopplertest"
+
+type secret struct {
+	Raw        string
+	Visibility string
+	ValueType  string
+	Note       string
+}
+
+// failure is a canned error response returned instead of handling a request
+type failure struct {
+	status    int
+	headers   map[string]string
+	remaining int
+}
+
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	nextID     int
+	failures   map[string]*failure
+	requests   map[string]int
+	headers    map[string]http.Header
+	projects   map[string]*doppler.Project
+	configs    map[string]*doppler.Config
+	secrets    map[string]map[string]*secret
+	identities map[string]map[string]map[string]interface{}
+	syncs      map[string]*doppler.Sync
+	logs       []doppler.ActivityLog
+	oidc       map[string]string
+}
+
+// NewServer starts a fake Doppler API server. Callers should Close it when finished.
+func NewServer() *Server {
+	s := &Server{
+		failures:   map[string]*failure{},
+		requests:   map[string]int{},
+		headers:    map[string]http.Header{},
+		projects:   map[string]*doppler.Project{},
+		configs:    map[string]*doppler.Config{},
+		secrets:    map[string]map[string]*secret{},
+		identities: map[string]map[string]map[string]interface{}{},
+		syncs:      map[string]*doppler.Sync{},
+		oidc:       map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v3/projects", s.createProject)
+	mux.HandleFunc("GET /v3/projects/project", s.getProject)
+	mux.HandleFunc("POST /v3/projects/project", s.updateProject)
+	mux.HandleFunc("DELETE /v3/projects/project", s.deleteProject)
+
+	mux.HandleFunc("POST /v3/configs", s.createConfig)
+	mux.HandleFunc("GET /v3/configs/config", s.getConfig)
+	mux.HandleFunc("POST /v3/configs/config", s.renameConfig)
+	mux.HandleFunc("DELETE /v3/configs/config", s.deleteConfig)
+
+	mux.HandleFunc("GET /v3/configs/config/secret", s.getSecret)
+	mux.HandleFunc("GET /v3/configs/config/secrets", s.listSecrets)
+	mux.HandleFunc("POST /v3/configs/config/secrets", s.updateSecrets)
+	mux.HandleFunc("GET /v3/configs/config/secrets/download", s.downloadSecrets)
+	mux.HandleFunc("POST /v3/configs/config/secrets/note", s.updateSecretNote)
+
+	mux.HandleFunc("POST /v3/auth/oidc", s.exchangeOIDCToken)
+	mux.HandleFunc("GET /v3/me", s.getMe)
+
+	identitiesPath := "/v3/workplace/service_accounts/service_account/{service_account}/identities"
+	mux.HandleFunc("POST "+identitiesPath, s.createIdentity)
+	mux.HandleFunc("GET "+identitiesPath+"/identity/{slug}", s.getIdentity)
+	mux.HandleFunc("PUT "+identitiesPath+"/identity/{slug}", s.updateIdentity)
+	mux.HandleFunc("DELETE "+identitiesPath+"/identity/{slug}", s.deleteIdentity)
+
+	mux.HandleFunc("POST /v3/configs/config/syncs", s.createSync)
+	mux.HandleFunc("GET /v3/configs/config/syncs/sync", s.getSync)
+	mux.HandleFunc("DELETE /v3/configs/config/syncs/sync", s.deleteSync)
+
+	mux.HandleFunc("GET /v3/logs", s.listActivityLogs)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		key := requestKey(r.Method, r.URL.Path)
+		s.headers[key] = r.Header.Clone()
+		// The OIDC exchange is authenticated by the OIDC token in its body
+		isOIDCExchange := r.Method == http.MethodPost && r.URL.Path == "/v3/auth/oidc"
+		if token, _, ok := r.BasicAuth(); !isOIDCExchange && (!ok || token != Token) {
+			writeError(w, http.StatusUnauthorized, "Invalid Auth token")
+			return
+		}
+		s.requests[key]++
+		if f, ok := s.failures[key]; ok && f.remaining > 0 {
+			f.remaining--
+			for name, value := range f.headers {
+				w.Header().Set(name, value)
+			}
+			writeError(w, f.status, http.StatusText(f.status))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+func requestKey(method string, path string) string {
+	return method + " " + path
+}
+
+// FailNext makes the next `count` requests to the method and path fail with the given status and
+// response headers (e.g. a 429 with a `retry-after` header) instead of reaching the fake API.
+func (s *Server) FailNext(method string, path string, count int, status int, headers map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[requestKey(method, path)] = &failure{status: status, headers: headers, remaining: count}
+}
+
+// Requests returns the number of authenticated requests received for the method and path,
+// including requests failed by FailNext.
+func (s *Server) Requests(method string, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[requestKey(method, path)]
+}
+
+func configKey(project string, config string) string {
+	return project + "/" + config
+}
+
+func (s *Server) newSlug(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_%d", prefix, s.nextID)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("content-type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error in the same shape as the Doppler API
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"messages": []string{message},
+		"success":  false,
+	})
+}
+
+func readBody(w http.ResponseWriter, r *http.Request, payload interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return false
+	}
+	return true
+}
+
+// RequestHeader returns the headers of the most recent request for the method and path, or nil if
+// there wasn't one.
+func (s *Server) RequestHeader(method string, path string) http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.headers[requestKey(method, path)]
+}
+
+// Auth
+
+// AddOIDCIdentity accepts the OIDC token for the identity. Exchanging it issues Token.
+func (s *Server) AddOIDCIdentity(identity string, oidcToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.oidc[identity] = oidcToken
+}
+
+func (s *Server) exchangeOIDCToken(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Identity string `json:"identity"`
+		Token    string `json:"token"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if oidcToken, ok := s.oidc[payload.Identity]; !ok || oidcToken != payload.Token {
+		writeError(w, http.StatusUnauthorized, "Invalid OIDC token")
+		return
+	}
+	writeJSON(w, http.StatusOK, doppler.OIDCAuthResponse{Token: Token, ExpiresAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)})
+}
+
+func (s *Server) getMe(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, doppler.Me{
+		Slug:         "dopplertest",
+		Name:         "dopplertest",
+		Type:         "personal",
+		TokenPreview: Token[:9] + "...",
+		CreatedAt:    now(),
+		LastSeenAt:   now(),
+		Workplace:    doppler.MeWorkplace{Slug: "workplace_1", Name: "Doppler Test"},
+	})
+}
+
+// Projects
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if _, ok := s.projects[payload.Name]; ok {
+		writeError(w, http.StatusBadRequest, "A project with this name already exists")
+		return
+	}
+	project := &doppler.Project{Slug: payload.Name, Name: payload.Name, Description: payload.Description, CreatedAt: now()}
+	s.projects[project.Name] = project
+	writeJSON(w, http.StatusOK, doppler.ProjectResponse{Project: *project})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.projects[r.URL.Query().Get("project")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested project")
+		return
+	}
+	writeJSON(w, http.StatusOK, doppler.ProjectResponse{Project: *project})
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project     string `json:"project"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	project, ok := s.projects[payload.Project]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested project")
+		return
+	}
+	if payload.Name != "" && payload.Name != project.Name {
+		delete(s.projects, project.Name)
+		for key, config := range s.configs {
+			if config.Project == project.Name {
+				delete(s.configs, key)
+				config.Project = payload.Name
+				newKey := configKey(config.Project, config.Name)
+				s.configs[newKey] = config
+				s.secrets[newKey] = s.secrets[key]
+				delete(s.secrets, key)
+			}
+		}
+		project.Name = payload.Name
+		project.Slug = payload.Name
+		s.projects[project.Name] = project
+	}
+	project.Description = payload.Description
+	writeJSON(w, http.StatusOK, doppler.ProjectResponse{Project: *project})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project string `json:"project"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if _, ok := s.projects[payload.Project]; !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested project")
+		return
+	}
+	delete(s.projects, payload.Project)
+	for key, config := range s.configs {
+		if config.Project == payload.Project {
+			delete(s.configs, key)
+			delete(s.secrets, key)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+// Configs
+
+func (s *Server) createConfig(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project     string `json:"project"`
+		Environment string `json:"environment"`
+		Name        string `json:"name"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if _, ok := s.projects[payload.Project]; !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested project")
+		return
+	}
+	key := configKey(payload.Project, payload.Name)
+	if _, ok := s.configs[key]; ok {
+		writeError(w, http.StatusBadRequest, "A config with this name already exists")
+		return
+	}
+	config := &doppler.Config{
+		Slug:        s.newSlug("config"),
+		Name:        payload.Name,
+		Project:     payload.Project,
+		Environment: payload.Environment,
+		Root:        payload.Name == payload.Environment,
+		CreatedAt:   now(),
+		Inherits:    []doppler.ConfigDescriptor{},
+	}
+	s.configs[key] = config
+	s.secrets[key] = map[string]*secret{}
+	writeJSON(w, http.StatusOK, doppler.ConfigResponse{Config: *config})
+}
+
+// findConfig writes a not found error and returns false if the config doesn't exist
+func (s *Server) findConfig(w http.ResponseWriter, project string, name string) (*doppler.Config, bool) {
+	config, ok := s.configs[configKey(project, name)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested config")
+	}
+	return config, ok
+}
+
+func (s *Server) getConfig(w http.ResponseWriter, r *http.Request) {
+	config, ok := s.findConfig(w, r.URL.Query().Get("project"), r.URL.Query().Get("config"))
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, doppler.ConfigResponse{Config: *config})
+}
+
+func (s *Server) renameConfig(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project string `json:"project"`
+		Config  string `json:"config"`
+		Name    string `json:"name"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	config, ok := s.findConfig(w, payload.Project, payload.Config)
+	if !ok {
+		return
+	}
+	oldKey := configKey(config.Project, config.Name)
+	newKey := configKey(config.Project, payload.Name)
+	delete(s.configs, oldKey)
+	s.secrets[newKey] = s.secrets[oldKey]
+	if newKey != oldKey {
+		delete(s.secrets, oldKey)
+	}
+	config.Name = payload.Name
+	s.configs[newKey] = config
+	writeJSON(w, http.StatusOK, doppler.ConfigResponse{Config: *config})
+}
+
+func (s *Server) deleteConfig(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project string `json:"project"`
+		Config  string `json:"config"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if _, ok := s.findConfig(w, payload.Project, payload.Config); !ok {
+		return
+	}
+	key := configKey(payload.Project, payload.Config)
+	delete(s.configs, key)
+	delete(s.secrets, key)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+// Secrets
+
+func (value *secret) toSecretValue() doppler.SecretValue {
+	raw := value.Raw
+	visibility := value.Visibility
+	valueType := doppler.ValueType{Type: value.ValueType}
+	note := value.Note
+	return doppler.SecretValue{
+		Raw:                &raw,
+		Computed:           &raw,
+		RawVisibility:      &visibility,
+		ComputedVisibility: &visibility,
+		RawValueType:       &valueType,
+		ComputedValueType:  &valueType,
+		Note:               &note,
+	}
+}
+
+func (s *Server) getSecret(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if _, ok := s.findConfig(w, query.Get("project"), query.Get("config")); !ok {
+		return
+	}
+	value, ok := s.secrets[configKey(query.Get("project"), query.Get("config"))][query.Get("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested secret")
+		return
+	}
+	writeJSON(w, http.StatusOK, doppler.Secret{Name: query.Get("name"), Value: value.toSecretValue()})
+}
+
+func (s *Server) listSecrets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if _, ok := s.findConfig(w, query.Get("project"), query.Get("config")); !ok {
+		return
+	}
+	result := doppler.SecretsResponse{Secrets: map[string]doppler.SecretValue{}}
+	for name, value := range s.secrets[configKey(query.Get("project"), query.Get("config"))] {
+		result.Secrets[name] = value.toSecretValue()
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) downloadSecrets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if _, ok := s.findConfig(w, query.Get("project"), query.Get("config")); !ok {
+		return
+	}
+	result := map[string]string{}
+	for name, value := range s.secrets[configKey(query.Get("project"), query.Get("config"))] {
+		result[name] = value.Raw
+	}
+
+	// Derive a stable ETag from the secrets (maps are marshalled with sorted keys) so conditional requests can be exercised
+	body, _ := json.Marshal(result)
+	etag := fmt.Sprintf("%q", fmt.Sprintf("%x", body))
+	w.Header().Set("etag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) updateSecrets(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project        string                  `json:"project"`
+		Config         string                  `json:"config"`
+		ChangeRequests []doppler.ChangeRequest `json:"change_requests"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	if _, ok := s.findConfig(w, payload.Project, payload.Config); !ok {
+		return
+	}
+	secrets := s.secrets[configKey(payload.Project, payload.Config)]
+	for _, change := range payload.ChangeRequests {
+		name := change.Name
+		if change.OriginalName != nil {
+			name = *change.OriginalName
+		}
+		existing, exists := secrets[name]
+		if change.OriginalValue != nil && (!exists || existing.Raw != *change.OriginalValue) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Secret %s has been modified", name))
+			return
+		}
+		if change.ShouldDelete {
+			delete(secrets, name)
+			continue
+		}
+		if !exists {
+			existing = &secret{Visibility: "masked", ValueType: "string"}
+		}
+		delete(secrets, name)
+		if change.Value != nil {
+			existing.Raw = *change.Value
+		}
+		if change.Visibility != "" {
+			existing.Visibility = change.Visibility
+		}
+		if change.ValueType != nil && change.ValueType.Type != "" {
+			existing.ValueType = change.ValueType.Type
+		}
+		secrets[change.Name] = existing
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+func (s *Server) updateSecretNote(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Project string `json:"project"`
+		Config  string `json:"config"`
+		Secret  string `json:"secret"`
+		Note    string `json:"note"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	// Notes are shared by the secret across every config in the project
+	found := false
+	for key, config := range s.configs {
+		if config.Project != payload.Project {
+			continue
+		}
+		if value, ok := s.secrets[key][payload.Secret]; ok {
+			value.Note = payload.Note
+			found = true
+		}
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, "Could not find requested secret")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"name": payload.Secret, "note": payload.Note})
+}
+
+// Syncs
+
+func (s *Server) createSync(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if _, ok := s.findConfig(w, query.Get("project"), query.Get("config")); !ok {
+		return
+	}
+	var payload struct {
+		Integration string                 `json:"integration"`
+		Data        map[string]interface{} `json:"data"`
+	}
+	if !readBody(w, r, &payload) {
+		return
+	}
+	// The sync completes immediately and its data is returned as it was sent
+	lastSyncedAt := now()
+	sync := &doppler.Sync{
+		Slug:         s.newSlug("sync"),
+		Project:      query.Get("project"),
+		Config:       query.Get("config"),
+		Integration:  payload.Integration,
+		Data:         payload.Data,
+		Enabled:      true,
+		LastSyncedAt: &lastSyncedAt,
+	}
+	s.syncs[sync.Slug] = sync
+	writeJSON(w, http.StatusOK, doppler.SyncResponse{Sync: *sync})
+}
+
+// findSync writes a not found error and returns false if the sync doesn't exist in the config
+func (s *Server) findSync(w http.ResponseWriter, r *http.Request) (*doppler.Sync, bool) {
+	query := r.URL.Query()
+	sync, ok := s.syncs[query.Get("sync")]
+	if !ok || sync.Project != query.Get("project") || sync.Config != query.Get("config") {
+		writeError(w, http.StatusNotFound, "Could not find requested sync")
+		return nil, false
+	}
+	return sync, true
+}
+
+func (s *Server) getSync(w http.ResponseWriter, r *http.Request) {
+	sync, ok := s.findSync(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, doppler.SyncResponse{Sync: *sync})
+}
+
+func (s *Server) deleteSync(w http.ResponseWriter, r *http.Request) {
+	sync, ok := s.findSync(w, r)
+	if !ok {
+		return
+	}
+	delete(s.syncs, sync.Slug)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+// Service Account Identities
+
+func (s *Server) createIdentity(w http.ResponseWriter, r *http.Request) {
+	var identity map[string]interface{}
+	if !readBody(w, r, &identity) {
+		return
+	}
+	serviceAccount := r.PathValue("service_account")
+	identity["slug"] = s.newSlug("identity")
+	identity["created_at"] = now()
+	if s.identities[serviceAccount] == nil {
+		s.identities[serviceAccount] = map[string]map[string]interface{}{}
+	}
+	s.identities[serviceAccount][identity["slug"].(string)] = identity
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identity": identity})
+}
+
+// findIdentity writes a not found error and returns false if the identity doesn't exist
+func (s *Server) findIdentity(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	identity, ok := s.identities[r.PathValue("service_account")][r.PathValue("slug")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Could not find requested identity")
+	}
+	return identity, ok
+}
+
+func (s *Server) getIdentity(w http.ResponseWriter, r *http.Request) {
+	identity, ok := s.findIdentity(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identity": identity})
+}
+
+// updateIdentity changes only the fields present in the request
+func (s *Server) updateIdentity(w http.ResponseWriter, r *http.Request) {
+	identity, ok := s.findIdentity(w, r)
+	if !ok {
+		return
+	}
+	var updated map[string]interface{}
+	if !readBody(w, r, &updated) {
+		return
+	}
+	// Updates replace the identity, except for the fields set by the API
+	updated["slug"] = identity["slug"]
+	updated["created_at"] = identity["created_at"]
+	s.identities[r.PathValue("service_account")][r.PathValue("slug")] = updated
+	writeJSON(w, http.StatusOK, map[string]interface{}{"identity": updated})
+}
+
+func (s *Server) deleteIdentity(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.findIdentity(w, r); !ok {
+		return
+	}
+	delete(s.identities[r.PathValue("service_account")], r.PathValue("slug"))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
+
+// Activity Logs
+
+// AddActivityLog records a workplace activity log. Logs must be added oldest first.
+func (s *Server) AddActivityLog(log doppler.ActivityLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs = append(s.logs, log)
+}
+
+// pageBounds returns the range of items on the requested page, given the `page` and `per_page` parameters
+func pageBounds(r *http.Request, total int) (int, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 20
+	}
+	start := min((page-1)*perPage, total)
+	return start, min(start+perPage, total)
+}
+
+func (s *Server) listActivityLogs(w http.ResponseWriter, r *http.Request) {
+	newestFirst := make([]doppler.ActivityLog, len(s.logs))
+	for i, log := range s.logs {
+		newestFirst[len(s.logs)-1-i] = log
+	}
+	start, end := pageBounds(r, len(newestFirst))
+	writeJSON(w, http.StatusOK, doppler.ActivityLogsResponse{Logs: newestFirst[start:end]})
+}
@@ -0,0 +1,9 @@
+data "doppler_activity_logs" "recent" {
+  project   = "backend"
+  since     = "2024-01-01T00:00:00Z"
+  page_size = 100
+}
+
+output "recent_backend_changes" {
+  value = [for log in data.doppler_activity_logs.recent.list : "${log.created_at} ${log.user_email}: ${log.text}"]
+}
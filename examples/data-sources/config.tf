@@ -0,0 +1,13 @@
+data "doppler_config" "backend_dev" {
+  project = "backend"
+  name    = "dev"
+}
+
+# Only attach service tokens to branch configs, never to root configs
+resource "doppler_service_token" "backend_dev" {
+  count   = data.doppler_config.backend_dev.root ? 0 : 1
+  project = data.doppler_config.backend_dev.project
+  config  = data.doppler_config.backend_dev.name
+  name    = "Backend Dev Token"
+  access  = "read"
+}
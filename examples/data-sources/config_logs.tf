@@ -0,0 +1,12 @@
+data "doppler_config_logs" "database_url" {
+  project = "backend"
+  config  = "prd"
+  secret  = "DATABASE_URL"
+}
+
+output "database_url_changes" {
+  value = [
+    for log in data.doppler_config_logs.database_url.list :
+    "${log.created_at} ${log.user_email}: ${log.text}"
+  ]
+}
@@ -0,0 +1,17 @@
+data "doppler_configs" "prd_branches" {
+  project     = "backend"
+  environment = "prd"
+  kind        = "branch"
+}
+
+# One sync per branch config in the `prd` environment
+resource "doppler_secrets_sync_flyio" "prd_branches" {
+  for_each = { for config in data.doppler_configs.prd_branches.list : config.name => config }
+
+  integration = doppler_integration_flyio.prod.id
+  project     = each.value.project
+  config      = each.value.name
+
+  app_id           = "my-app-${each.key}"
+  restart_machines = true
+}
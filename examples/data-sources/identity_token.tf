@@ -0,0 +1,19 @@
+variable "tfc_workload_identity_token" {
+  type      = string
+  sensitive = true
+}
+
+data "doppler_identity_token" "cluster" {
+  identity_id = doppler_service_account_identity.cluster_bootstrap.slug
+  oidc_token  = var.tfc_workload_identity_token
+}
+
+# Use the short-lived Doppler token to configure another provider block
+provider "doppler" {
+  alias         = "cluster"
+  doppler_token = data.doppler_identity_token.cluster.token
+}
+
+data "doppler_secrets" "cluster" {
+  provider = doppler.cluster
+}
@@ -0,0 +1,12 @@
+data "doppler_me" "current" {}
+
+resource "doppler_project" "backend" {
+  name = "backend"
+
+  lifecycle {
+    precondition {
+      condition     = data.doppler_me.current.type != "service_token"
+      error_message = "Managing projects requires a workplace-level token, not a config-scoped service token."
+    }
+  }
+}
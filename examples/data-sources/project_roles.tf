@@ -0,0 +1,11 @@
+data "doppler_project_roles" "all" {}
+
+locals {
+  project_roles = { for role in data.doppler_project_roles.all.list : role.name => role.identifier }
+}
+
+resource "doppler_project_member_group" "backend_engineering" {
+  project    = "backend"
+  group_slug = doppler_group.engineering.slug
+  role       = local.project_roles["Collaborator"]
+}
@@ -0,0 +1,12 @@
+data "doppler_rotated_secret" "cloudflare" {
+  project = "backend"
+  config  = "dev"
+  slug    = doppler_rotated_secret_cloudflare_tokens.rs_cf.id
+}
+
+output "cloudflare_rotation_overdue" {
+  value = (
+    data.doppler_rotated_secret.cloudflare.next_rotation_at != "" &&
+    timecmp(data.doppler_rotated_secret.cloudflare.next_rotation_at, plantimestamp()) < 0
+  )
+}
@@ -0,0 +1,13 @@
+data "doppler_secret" "feature_flags" {
+  project = "backend"
+  config  = "prd"
+  name    = "FEATURE_FLAGS"
+
+  # Returned if FEATURE_FLAGS does not exist in the config
+  default = "{}"
+}
+
+output "feature_flags" {
+  # nonsensitive used for demo purposes only
+  value = nonsensitive(jsondecode(data.doppler_secret.feature_flags.value))
+}
@@ -56,3 +56,19 @@ output "port-dev" {
 output "port-prd" {
   value = nonsensitive(data.doppler_secrets.prd.map.PORT)
 }
+
+### Inspecting secret metadata
+
+data "doppler_secrets" "metadata" {}
+
+locals {
+  secrets_by_name = { for secret in data.doppler_secrets.metadata.secrets : secret.name => secret }
+}
+
+output "unmasked_secrets" {
+  value = [for secret in data.doppler_secrets.metadata.secrets : secret.name if secret.visibility == "unmasked"]
+}
+
+output "database_url_note" {
+  value = local.secrets_by_name["DATABASE_URL"].note
+}
@@ -0,0 +1,15 @@
+data "doppler_secrets_download" "backend_prod" {
+  project = "backend"
+  config  = "prd"
+  format  = "env"
+}
+
+resource "kubernetes_secret" "backend" {
+  metadata {
+    name = "backend-env"
+  }
+
+  data = {
+    ".env" = data.doppler_secrets_download.backend_prod.content
+  }
+}
@@ -0,0 +1,8 @@
+data "doppler_service_account_identity" "ci" {
+  service_account_slug = "00000000-0000-0000-0000-000000000000"
+  slug                 = "00000000-0000-0000-0000-000000000000"
+}
+
+output "ci_identity_discovery_url" {
+  value = data.doppler_service_account_identity.ci.config_oidc[0].discovery_url
+}
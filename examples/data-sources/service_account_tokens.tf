@@ -0,0 +1,11 @@
+data "doppler_service_account_tokens" "ci" {
+  service_account_slug = doppler_service_account.ci.slug
+}
+
+# Tokens that haven't been used in the last 90 days
+output "stale_token_names" {
+  value = [
+    for token in data.doppler_service_account_tokens.ci.list : token.name
+    if token.last_seen_at == "" || timecmp(token.last_seen_at, timeadd(plantimestamp(), "-2160h")) < 0
+  ]
+}
@@ -0,0 +1,5 @@
+data "doppler_service_accounts" "all" {}
+
+output "service_account_slugs" {
+  value = [for sa in data.doppler_service_accounts.all.list : sa.slug]
+}
@@ -0,0 +1,10 @@
+data "doppler_workplace" "current" {}
+
+resource "doppler_project" "backend" {
+  name        = "${lower(replace(data.doppler_workplace.current.name, " ", "-"))}-backend"
+  description = "Backend services for ${data.doppler_workplace.current.name}"
+}
+
+output "security_email" {
+  value = data.doppler_workplace.current.security_email
+}
@@ -0,0 +1,10 @@
+data "doppler_workplace_roles" "all" {}
+
+locals {
+  workplace_roles = { for role in data.doppler_workplace_roles.all.list : role.name => role.identifier }
+}
+
+resource "doppler_service_account" "ci" {
+  name           = "ci"
+  workplace_role = local.workplace_roles["Viewer"]
+}
@@ -0,0 +1,18 @@
+variable "databases" {
+  type    = set(string)
+  default = ["orders-db", "user sessions"]
+}
+
+resource "random_password" "database" {
+  for_each = var.databases
+  length   = 32
+}
+
+resource "doppler_secret" "database_passwords" {
+  for_each = var.databases
+  project  = "backend"
+  config   = "prd"
+  # e.g. `orders-db` becomes `ORDERS_DB_PASSWORD`
+  name  = provider::doppler::normalize_name("${each.key} password")
+  value = random_password.database[each.key].result
+}
@@ -0,0 +1,6 @@
+resource "doppler_secret" "api_database_url" {
+  project = "api"
+  config  = "prd"
+  name    = "DATABASE_URL"
+  value   = provider::doppler::secret_ref("shared", "prd", "DATABASE_URL")
+}
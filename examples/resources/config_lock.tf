@@ -0,0 +1,12 @@
+variable "production_projects" {
+  type    = set(string)
+  default = ["backend", "frontend"]
+}
+
+# Lock the root production configs, which are managed by each project's own module
+resource "doppler_config_lock" "production" {
+  for_each = var.production_projects
+
+  project = each.value
+  config  = "prd"
+}
@@ -0,0 +1,19 @@
+variable "app_version" {
+  type = string
+}
+
+variable "secrets_version" {
+  description = "The config log ID of the secrets released with the app version"
+  type        = string
+}
+
+# Roll the config's secrets back alongside the application
+resource "doppler_config_rollback" "backend_prd" {
+  project = "backend"
+  config  = "prd"
+  log_id  = var.secrets_version
+
+  triggers = {
+    app_version = var.app_version
+  }
+}
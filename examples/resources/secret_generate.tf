@@ -0,0 +1,15 @@
+resource "doppler_secret" "api_signing_key" {
+  project = "backend"
+  config  = "dev"
+  name    = "API_SIGNING_KEY"
+
+  generate {
+    length  = 48
+    special = true
+  }
+
+  # Changing a keeper generates a new value
+  keepers = {
+    rotated_on = "2024-01-01"
+  }
+}
@@ -0,0 +1,18 @@
+# App-level environment variables
+resource "doppler_secrets_sync_digitalocean_app" "backend_prod" {
+  integration = "bae40485-eca7-478b-abd8-34100c82c679"
+  project     = "backend"
+  config      = "prd"
+
+  app_id = "a6b9a0c2-3f3f-4f4e-9b5a-5f1d2c3b4a59"
+}
+
+# Component environment variables
+resource "doppler_secrets_sync_digitalocean_app" "backend_prod_worker" {
+  integration = "bae40485-eca7-478b-abd8-34100c82c679"
+  project     = "backend"
+  config      = "prd_worker"
+
+  app_id    = "a6b9a0c2-3f3f-4f4e-9b5a-5f1d2c3b4a59"
+  component = "worker"
+}
@@ -0,0 +1,9 @@
+resource "doppler_service_token" "deploy_token" {
+  project = "backend"
+  config  = "ci"
+  name    = "Deploy Token"
+  access  = "read"
+  ttl     = "720h"
+}
+
+# Expiration available as `doppler_service_token.deploy_token.expires_at`
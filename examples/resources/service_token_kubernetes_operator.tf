@@ -0,0 +1,38 @@
+resource "doppler_service_token" "operator" {
+  project = "backend"
+  config  = "prd"
+  name    = "Kubernetes Operator"
+  access  = "read"
+}
+
+# The Doppler Kubernetes Operator reads its service token from a Kubernetes secret
+resource "kubernetes_secret" "doppler_token" {
+  metadata {
+    name      = "doppler-token-secret"
+    namespace = "doppler-operator-system"
+  }
+
+  data = {
+    serviceToken = doppler_service_token.operator.key
+  }
+}
+
+resource "kubernetes_manifest" "doppler_secret" {
+  manifest = {
+    apiVersion = "secrets.doppler.com/v1alpha1"
+    kind       = "DopplerSecret"
+    metadata = {
+      name      = "backend-prd"
+      namespace = "doppler-operator-system"
+    }
+    spec = {
+      tokenSecret = {
+        name = kubernetes_secret.doppler_token.metadata[0].name
+      }
+      managedSecret = {
+        name      = "backend-secrets"
+        namespace = "default"
+      }
+    }
+  }
+}
@@ -0,0 +1,14 @@
+resource "random_password" "bootstrap" {
+  length = 32
+}
+
+resource "doppler_share_link" "bootstrap" {
+  value        = random_password.bootstrap.result
+  expire_views = 1
+  expire_days  = 3
+}
+
+output "bootstrap_link" {
+  value     = doppler_share_link.bootstrap.url
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+resource "doppler_trusted_ips" "backend_prd" {
+  project = "backend"
+  config = "prd"
+  ips = [
+    "10.0.0.0/8",
+    "203.0.113.10",
+  ]
+}
@@ -0,0 +1,5 @@
+resource "doppler_workplace_settings" "default" {
+  name           = "Acme Corp"
+  billing_email  = "billing@example.com"
+  security_email = "security@example.com"
+}
@@ -0,0 +1,4 @@
+resource "doppler_workplace_user" "alice" {
+  email  = "alice@example.com"
+  access = "collaborator"
+}
@@ -1,7 +1,7 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
 	"github.com/DopplerHQ/terraform-provider-doppler/doppler"
@@ -9,8 +9,8 @@ import (
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return doppler.Provider()
+		GRPCProviderFunc: func() tfprotov5.ProviderServer {
+			return doppler.NewProviderServer()
 		},
 	})
 }